@@ -14,15 +14,34 @@ import (
 	"financial-backend/internal/events"
 	_ "financial-backend/internal/events"
 	"financial-backend/internal/gateways"
+	"financial-backend/internal/jobs"
+	"financial-backend/internal/models"
+	attachmentRepo "financial-backend/internal/repositories/attachment"
 	budgetRepo "financial-backend/internal/repositories/budget"
+	budgetAlertRepo "financial-backend/internal/repositories/budget_alert"
 	budgetMovementRepo "financial-backend/internal/repositories/budget_movement"
+	categoryRepo "financial-backend/internal/repositories/category"
+	creditCardRepo "financial-backend/internal/repositories/credit_card"
 	expenseRepo "financial-backend/internal/repositories/expense"
 	incomeRepo "financial-backend/internal/repositories/income"
+	installmentRepo "financial-backend/internal/repositories/installment"
+	memberRepo "financial-backend/internal/repositories/member"
+	settlementRepo "financial-backend/internal/repositories/settlement"
+	attachmentUseCase "financial-backend/internal/usecases/attachment"
 	budgetUseCase "financial-backend/internal/usecases/budget"
+	budgetAdjustmentUseCase "financial-backend/internal/usecases/budget_adjustment"
+	budgetAlertUseCase "financial-backend/internal/usecases/budget_alert"
 	budgetMovementUseCase "financial-backend/internal/usecases/budget_movement"
+	categoryUseCase "financial-backend/internal/usecases/category"
+	creditCardUseCase "financial-backend/internal/usecases/credit_card"
 	expenseUseCase "financial-backend/internal/usecases/expense"
 	incomeUseCase "financial-backend/internal/usecases/income"
+	installmentUseCase "financial-backend/internal/usecases/installment"
+	memberUseCase "financial-backend/internal/usecases/member"
+	settlementUseCase "financial-backend/internal/usecases/settlement"
 	"financial-backend/pkg/config"
+	"financial-backend/pkg/notifier"
+	"financial-backend/pkg/storage"
 	"financial-backend/pkg/telemetry"
 
 	"github.com/gin-gonic/gin"
@@ -51,34 +70,88 @@ func main() {
 
 	eventPublisher := config.GetPublisher()
 
+	// Inicializa o armazenamento de anexos
+	blobStore, err := storage.NewLocalStore(cfg.StorageDir)
+	if err != nil {
+		log.Fatalf("Erro ao inicializar armazenamento de anexos: %v", err)
+	}
+
 	// Inicializa os repositórios
 	expenseRepository := expenseRepo.NewRepository(db)
 	incomeRepository := incomeRepo.NewRepository(db)
 	budgetRepository := budgetRepo.NewRepository(db)
 	budgetMovementRepository := budgetMovementRepo.NewRepository(db)
+	creditCardRepository := creditCardRepo.NewRepository(db)
+	installmentRepository := installmentRepo.NewRepository(db)
+	categoryRepository := categoryRepo.NewRepository(db)
+	attachmentRepository := attachmentRepo.NewRepository(db)
+	memberRepository := memberRepo.NewRepository(db)
+	settlementRepository := settlementRepo.NewRepository(db)
+	budgetAlertRepository := budgetAlertRepo.NewRepository(db)
 
 	// Inicializa os gateways
 	expenseGateway := gateways.NewExpenseGateway(expenseRepository)
 	incomeGateway := gateways.NewIncomeGateway(incomeRepository)
 	budgetGateway := gateways.NewBudgetGateway(budgetRepository)
 	budgetMovementGateway := gateways.NewBudgetMovementGateway(budgetMovementRepository)
+	creditCardGateway := gateways.NewCreditCardGateway(creditCardRepository)
+	installmentGateway := gateways.NewInstallmentGateway(installmentRepository)
+	categoryGateway := gateways.NewCategoryGateway(categoryRepository)
+	attachmentGateway := gateways.NewAttachmentGateway(attachmentRepository)
+	memberGateway := gateways.NewMemberGateway(memberRepository)
+	settlementGateway := gateways.NewSettlementGateway(settlementRepository)
+	budgetAlertGateway := gateways.NewBudgetAlertGateway(budgetAlertRepository)
 
 	// Inicializa os casos de uso
-	expenseUC := expenseUseCase.NewUseCase(expenseGateway, budgetGateway, eventPublisher, cfg.DefaultDueDate)
-	incomeUC := incomeUseCase.NewUseCase(incomeGateway)
-	budgetUC := budgetUseCase.NewUseCase(budgetGateway)
-	budgetMovementUC := budgetMovementUseCase.NewBudgetMovementUseCase(budgetMovementGateway, budgetGateway, expenseGateway)
-	dashboardUC := dashboard.NewDashBoardUseCase(expenseGateway, incomeGateway, budgetMovementGateway)
+	expenseUC := expenseUseCase.NewUseCase(expenseGateway, budgetGateway, creditCardGateway, categoryGateway, memberGateway, eventPublisher, cfg.DefaultDueDate)
+	incomeUC := incomeUseCase.NewUseCase(incomeGateway, categoryGateway)
+	budgetUC := budgetUseCase.NewUseCase(budgetGateway, budgetMovementGateway)
+	budgetAlertUC := budgetAlertUseCase.NewUseCase(budgetGateway, budgetMovementGateway, budgetAlertGateway, eventPublisher, cfg.AlertThresholds)
+	budgetMovementUC := budgetMovementUseCase.NewBudgetMovementUseCase(budgetMovementGateway, budgetGateway, expenseGateway, installmentGateway, budgetAlertUC)
+	budgetAdjustmentUC := budgetAdjustmentUseCase.NewUseCase(budgetGateway, budgetMovementGateway, eventPublisher, budgetAlertUC)
+	dashboardUC := dashboard.NewDashBoardUseCase(expenseGateway, incomeGateway, budgetMovementGateway, installmentGateway)
+	creditCardUC := creditCardUseCase.NewUseCase(creditCardGateway, expenseGateway, installmentGateway)
+	installmentUC := installmentUseCase.NewUseCase(installmentGateway, expenseGateway, budgetMovementGateway)
+	categoryUC := categoryUseCase.NewUseCase(categoryGateway)
+	attachmentUC := attachmentUseCase.NewUseCase(attachmentGateway, expenseGateway, incomeGateway, blobStore)
+	memberUC := memberUseCase.NewUseCase(memberGateway)
+	settlementUC := settlementUseCase.NewUseCase(settlementGateway, expenseGateway, memberGateway)
 
 	// Inicializa os controllers
 	expenseController := controllers.NewExpenseController(expenseUC)
 	incomeController := controllers.NewIncomeController(incomeUC)
 	budgetController := controllers.NewBudgetController(budgetUC)
 	budgetMovementController := controllers.NewBudgetMovementController(budgetMovementUC)
+	budgetAdjustmentController := controllers.NewBudgetAdjustmentController(budgetAdjustmentUC)
+	budgetAlertController := controllers.NewBudgetAlertController(budgetAlertUC)
 	dashboardController := controllers.NewDashboardController(dashboardUC)
+	creditCardController := controllers.NewCreditCardController(creditCardUC)
+	installmentController := controllers.NewInstallmentController(installmentUC)
+	categoryController := controllers.NewCategoryController(categoryUC)
+	attachmentController := controllers.NewAttachmentController(attachmentUC)
+	memberController := controllers.NewMemberController(memberUC)
+	settlementController := controllers.NewSettlementController(settlementUC)
 
 	//register handlers
 	eventPublisher.RegisterHandler(events.NewExpenseCreatedHandler(db, budgetMovementUC))
+	eventPublisher.RegisterHandler(events.NewExpenseUpdatedHandler(budgetMovementUC))
+	eventPublisher.RegisterHandler(events.NewExpenseDeletedHandler(budgetMovementUC))
+	eventPublisher.RegisterHandler(events.NewExpenseRestoredHandler(budgetMovementUC))
+	eventPublisher.RegisterHandler(events.NewExpenseOverdueHandler())
+	eventPublisher.RegisterHandler(events.NewBudgetAdjustedHandler())
+	eventPublisher.RegisterHandler(events.NewBudgetThresholdCrossedHandler(map[models.NotifierChannel]notifier.Notifier{
+		models.NotifierWebhook: notifier.NewWebhook(cfg.WebhookTimeout),
+		models.NotifierSMTP: notifier.NewSMTP(notifier.SMTPConfig{
+			Host:     cfg.SMTPHost,
+			Port:     cfg.SMTPPort,
+			User:     cfg.SMTPUser,
+			Password: cfg.SMTPPassword,
+			From:     cfg.SMTPFrom,
+		}),
+	}))
+	for _, handler := range events.NewExpenseInstallmentsHandlers(installmentUC, budgetMovementUC) {
+		eventPublisher.RegisterHandler(handler)
+	}
 
 	// Configura o router
 	router := gin.Default()
@@ -114,7 +187,15 @@ func main() {
 		incomeController.RegisterRoutes(api)
 		budgetController.RegisterRoutes(api)
 		budgetMovementController.RegisterRoutes(api)
+		budgetAdjustmentController.RegisterRoutes(api)
+		budgetAlertController.RegisterRoutes(api)
 		dashboardController.RegisterRoutes(api)
+		creditCardController.RegisterRoutes(api)
+		installmentController.RegisterRoutes(api)
+		categoryController.RegisterRoutes(api)
+		attachmentController.RegisterRoutes(api)
+		memberController.RegisterRoutes(api)
+		settlementController.RegisterRoutes(api)
 	}
 
 	// Configura o servidor HTTP
@@ -130,11 +211,19 @@ func main() {
 		}
 	}()
 
+	// Inicia a verificação periódica de despesas vencidas
+	jobsCtx, stopJobs := context.WithCancel(context.Background())
+	go jobs.NewOverdueCheck(expenseUC, cfg.OverdueCheckInterval).Start(jobsCtx)
+
+	// Preenche as parcelas e os vínculos das movimentações das despesas parceladas antigas
+	go jobs.NewInstallmentBackfill(installmentUC, budgetMovementUC).Run(jobsCtx)
+
 	// Configura o canal para capturar sinais de interrupção
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
 	log.Println("Desligando o servidor...")
+	stopJobs()
 
 	// Contexto com timeout para o shutdown
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
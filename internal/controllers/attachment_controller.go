@@ -0,0 +1,102 @@
+package controllers
+
+import (
+	"fmt"
+	"net/http"
+
+	"financial-backend/internal/models"
+	"financial-backend/internal/usecases/attachment"
+
+	"github.com/gin-gonic/gin"
+)
+
+type AttachmentController struct {
+	useCase attachment.UseCase
+}
+
+func NewAttachmentController(useCase attachment.UseCase) *AttachmentController {
+	return &AttachmentController{useCase: useCase}
+}
+
+func (c *AttachmentController) Upload(ownerType models.AttachmentOwner) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		ctx.Request.Body = http.MaxBytesReader(ctx.Writer, ctx.Request.Body, models.MaxAttachmentSize+(1<<20))
+
+		header, err := ctx.FormFile("file")
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		file, err := header.Open()
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		defer file.Close()
+
+		response, err := c.useCase.Upload(ctx, ownerType, ctx.Param("id"), header.Filename, header.Header.Get("Content-Type"), file)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		ctx.JSON(http.StatusCreated, response)
+	}
+}
+
+func (c *AttachmentController) List(ownerType models.AttachmentOwner) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		response, err := c.useCase.List(ctx, ownerType, ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		ctx.JSON(http.StatusOK, response)
+	}
+}
+
+func (c *AttachmentController) Download(ownerType models.AttachmentOwner) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		metadata, content, err := c.useCase.Download(ctx, ownerType, ctx.Param("id"), ctx.Param("attachmentId"))
+		if err != nil {
+			ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		defer content.Close()
+
+		ctx.DataFromReader(http.StatusOK, metadata.Size, metadata.ContentType, content, map[string]string{
+			"Content-Disposition": fmt.Sprintf("attachment; filename=%q", metadata.FileName),
+			"Digest":              "sha-256=" + metadata.Checksum,
+		})
+	}
+}
+
+func (c *AttachmentController) Delete(ownerType models.AttachmentOwner) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if err := c.useCase.Delete(ctx, ownerType, ctx.Param("id"), ctx.Param("attachmentId")); err != nil {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		ctx.Status(http.StatusNoContent)
+	}
+}
+
+func (c *AttachmentController) RegisterRoutes(router *gin.RouterGroup) {
+	owners := map[string]models.AttachmentOwner{
+		"/expenses/:id/attachments": models.AttachmentOwnerExpense,
+		"/incomes/:id/attachments":  models.AttachmentOwnerIncome,
+	}
+
+	for path, ownerType := range owners {
+		attachments := router.Group(path)
+		{
+			attachments.POST("", c.Upload(ownerType))
+			attachments.GET("", c.List(ownerType))
+			attachments.GET("/:attachmentId", c.Download(ownerType))
+			attachments.DELETE("/:attachmentId", c.Delete(ownerType))
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package controllers
+
+import (
+	"net/http"
+
+	"financial-backend/internal/dtos"
+	budgetadjustment "financial-backend/internal/usecases/budget_adjustment"
+
+	"github.com/gin-gonic/gin"
+)
+
+type BudgetAdjustmentController struct {
+	useCase budgetadjustment.UseCase
+}
+
+func NewBudgetAdjustmentController(useCase budgetadjustment.UseCase) *BudgetAdjustmentController {
+	return &BudgetAdjustmentController{useCase: useCase}
+}
+
+func (c *BudgetAdjustmentController) Adjust(ctx *gin.Context) {
+	var input dtos.BudgetAdjustmentRequest
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err := input.Validate(); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	response, err := c.useCase.Adjust(ctx, ctx.Param("id"), input)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, response)
+}
+
+func (c *BudgetAdjustmentController) RegisterRoutes(router *gin.RouterGroup) {
+	router.POST("/budgets/:id/adjustments", c.Adjust)
+}
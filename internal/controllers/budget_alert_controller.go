@@ -0,0 +1,70 @@
+package controllers
+
+import (
+	"net/http"
+
+	"financial-backend/internal/dtos"
+	budgetalert "financial-backend/internal/usecases/budget_alert"
+
+	"github.com/gin-gonic/gin"
+)
+
+type BudgetAlertController struct {
+	useCase budgetalert.UseCase
+}
+
+func NewBudgetAlertController(useCase budgetalert.UseCase) *BudgetAlertController {
+	return &BudgetAlertController{useCase: useCase}
+}
+
+func (c *BudgetAlertController) CreateNotifier(ctx *gin.Context) {
+	var input dtos.BudgetNotifierRequest
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	response, err := c.useCase.CreateNotifier(ctx, ctx.Param("id"), input)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, response)
+}
+
+func (c *BudgetAlertController) ListNotifiers(ctx *gin.Context) {
+	response, err := c.useCase.ListNotifiers(ctx, ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (c *BudgetAlertController) DeleteNotifier(ctx *gin.Context) {
+	if err := c.useCase.DeleteNotifier(ctx, ctx.Param("id"), ctx.Param("notifierId")); err != nil {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+func (c *BudgetAlertController) ListAlerts(ctx *gin.Context) {
+	response, err := c.useCase.ListAlerts(ctx, ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (c *BudgetAlertController) RegisterRoutes(router *gin.RouterGroup) {
+	router.GET("/budgets/:id/notifiers", c.ListNotifiers)
+	router.POST("/budgets/:id/notifiers", c.CreateNotifier)
+	router.DELETE("/budgets/:id/notifiers/:notifierId", c.DeleteNotifier)
+	router.GET("/budgets/:id/alerts", c.ListAlerts)
+}
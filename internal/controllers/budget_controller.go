@@ -60,6 +60,16 @@ func (c *BudgetController) Delete(ctx *gin.Context) {
 	ctx.Status(http.StatusNoContent)
 }
 
+func (c *BudgetController) Restore(ctx *gin.Context) {
+	response, err := c.useCase.Restore(ctx, ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
 func (c *BudgetController) Get(ctx *gin.Context) {
 	id := ctx.Param("id")
 	response, err := c.useCase.Get(ctx, id)
@@ -71,6 +81,16 @@ func (c *BudgetController) Get(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, response)
 }
 
+func (c *BudgetController) History(ctx *gin.Context) {
+	response, err := c.useCase.History(ctx, ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
 func (c *BudgetController) List(ctx *gin.Context) {
 	var params dtos.BudgetListParams
 
@@ -94,7 +114,9 @@ func (c *BudgetController) RegisterRoutes(router *gin.RouterGroup) {
 		budgets.POST("", c.Create)
 		budgets.PUT("/:id", c.Update)
 		budgets.DELETE("/:id", c.Delete)
+		budgets.POST("/:id/restore", c.Restore)
 		budgets.GET("/:id", c.Get)
+		budgets.GET("/:id/history", c.History)
 		budgets.GET("", c.List)
 	}
 }
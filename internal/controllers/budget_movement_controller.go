@@ -60,6 +60,44 @@ func (c *BudgetMovementController) ProcessMovements(ctx *gin.Context) {
 	ctx.Status(http.StatusNoContent)
 }
 
+func (c *BudgetMovementController) Transfer(ctx *gin.Context) {
+	var input dtos.BudgetTransferRequest
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	var params dtos.BudgetTransferParams
+	if err := ctx.ShouldBindQuery(&params); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	response, err := c.useCase.Transfer(ctx, ctx.Param("id"), input, params.Force)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, response)
+}
+
+func (c *BudgetMovementController) Balance(ctx *gin.Context) {
+	var params dtos.BudgetBalanceParams
+	if err := ctx.ShouldBindQuery(&params); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	response, err := c.useCase.Balance(ctx, ctx.Param("id"), params)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
 func (c *BudgetMovementController) RegisterRoutes(router *gin.RouterGroup) {
 	budgets := router.Group("/movements")
 	{
@@ -67,4 +105,7 @@ func (c *BudgetMovementController) RegisterRoutes(router *gin.RouterGroup) {
 		budgets.GET("", c.Find)
 		budgets.POST("/recurrent", c.ProcessMovements)
 	}
+
+	router.POST("/budgets/:id/transfers", c.Transfer)
+	router.GET("/budgets/:id/balance", c.Balance)
 }
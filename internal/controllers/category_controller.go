@@ -0,0 +1,91 @@
+package controllers
+
+import (
+	"net/http"
+
+	"financial-backend/internal/dtos"
+	"financial-backend/internal/usecases/category"
+
+	"github.com/gin-gonic/gin"
+)
+
+type CategoryController struct {
+	useCase category.UseCase
+}
+
+func NewCategoryController(useCase category.UseCase) *CategoryController {
+	return &CategoryController{useCase: useCase}
+}
+
+func (c *CategoryController) Create(ctx *gin.Context) {
+	var input dtos.CreateCategoryRequest
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	response, err := c.useCase.Create(ctx, &input)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, response)
+}
+
+func (c *CategoryController) Update(ctx *gin.Context) {
+	id := ctx.Param("id")
+	var input dtos.UpdateCategoryRequest
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	response, err := c.useCase.Update(ctx, id, &input)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (c *CategoryController) Delete(ctx *gin.Context) {
+	if err := c.useCase.Delete(ctx, ctx.Param("id")); err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+func (c *CategoryController) Get(ctx *gin.Context) {
+	response, err := c.useCase.Get(ctx, ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (c *CategoryController) List(ctx *gin.Context) {
+	response, err := c.useCase.List(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (c *CategoryController) RegisterRoutes(router *gin.RouterGroup) {
+	categories := router.Group("/categories")
+	{
+		categories.POST("", c.Create)
+		categories.PUT("/:id", c.Update)
+		categories.DELETE("/:id", c.Delete)
+		categories.GET("/:id", c.Get)
+		categories.GET("", c.List)
+	}
+}
@@ -0,0 +1,144 @@
+package controllers
+
+import (
+	"net/http"
+
+	"financial-backend/internal/dtos"
+	creditcard "financial-backend/internal/usecases/credit_card"
+
+	"github.com/gin-gonic/gin"
+)
+
+type CreditCardController struct {
+	useCase creditcard.UseCase
+}
+
+func NewCreditCardController(useCase creditcard.UseCase) *CreditCardController {
+	return &CreditCardController{useCase: useCase}
+}
+
+func (c *CreditCardController) Create(ctx *gin.Context) {
+	var input dtos.CreateCreditCardRequest
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	response, err := c.useCase.Create(ctx, input)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, response)
+}
+
+func (c *CreditCardController) Update(ctx *gin.Context) {
+	id := ctx.Param("id")
+	var input dtos.UpdateCreditCardRequest
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	response, err := c.useCase.Update(ctx, id, &input)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (c *CreditCardController) Delete(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if err := c.useCase.Delete(ctx, id); err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+func (c *CreditCardController) Get(ctx *gin.Context) {
+	id := ctx.Param("id")
+	response, err := c.useCase.Get(ctx, id)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (c *CreditCardController) List(ctx *gin.Context) {
+	var params dtos.CreditCardListParams
+
+	if err := ctx.ShouldBindQuery(&params); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "parâmetros inválidos"})
+		return
+	}
+
+	response, err := c.useCase.List(ctx, params)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (c *CreditCardController) Statement(ctx *gin.Context) {
+	id := ctx.Param("id")
+	var params dtos.StatementParams
+	if err := ctx.ShouldBindUri(&params); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	response, err := c.useCase.Statement(ctx, id, params)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (c *CreditCardController) PayStatement(ctx *gin.Context) {
+	id := ctx.Param("id")
+	var params dtos.StatementParams
+	if err := ctx.ShouldBindUri(&params); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	var input dtos.PayStatementRequest
+	if ctx.Request.ContentLength > 0 {
+		if err := ctx.ShouldBindJSON(&input); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	response, err := c.useCase.PayStatement(ctx, id, params, &input)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, response)
+}
+
+func (c *CreditCardController) RegisterRoutes(router *gin.RouterGroup) {
+	cards := router.Group("/cards")
+	{
+		cards.POST("", c.Create)
+		cards.PUT("/:id", c.Update)
+		cards.DELETE("/:id", c.Delete)
+		cards.GET("/:id", c.Get)
+		cards.GET("", c.List)
+		cards.GET("/:id/statements/:year/:month", c.Statement)
+		cards.POST("/:id/statements/:year/:month/payments", c.PayStatement)
+	}
+}
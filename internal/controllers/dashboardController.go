@@ -51,10 +51,75 @@ func (d *DashboardController) SummaryBudgetUsageByMonthYear(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, summary)
 }
 
+func (d *DashboardController) BudgetUtilizationDrillDown(ctx *gin.Context) {
+	var input dtos.SummaryQueryParams
+
+	if err := ctx.ShouldBindQuery(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	drillDown, err := d.uc.BudgetUtilizationDrillDown(ctx, ctx.Param("id"), input.Month, input.Year)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, drillDown)
+}
+
+func (d *DashboardController) BudgetForecast(ctx *gin.Context) {
+	var input dtos.SummaryQueryParams
+
+	if err := ctx.ShouldBindQuery(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	forecast, err := d.uc.BudgetForecast(ctx, input.Month, input.Year)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, forecast)
+}
+
+func (d *DashboardController) InstallmentsSummary(ctx *gin.Context) {
+	summary, err := d.uc.InstallmentsSummary(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, summary)
+}
+
+func (d *DashboardController) TagBreakdown(ctx *gin.Context) {
+	var input dtos.SummaryQueryParams
+
+	if err := ctx.ShouldBindQuery(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	breakdown, err := d.uc.TagBreakdown(ctx, input.Month, input.Year)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, breakdown)
+}
+
 func (d *DashboardController) RegisterRoutes(api *gin.RouterGroup) {
 	api = api.Group("/dashboard")
 	{
 		api.GET("/summary", d.GetSummary)
 		api.GET("/budget/utilization", d.SummaryBudgetUsageByMonthYear)
+		api.GET("/budget/utilization/:id", d.BudgetUtilizationDrillDown)
+		api.GET("/forecast", d.BudgetForecast)
+		api.GET("/installments", d.InstallmentsSummary)
+		api.GET("/tags", d.TagBreakdown)
 	}
 }
@@ -35,9 +35,42 @@ func (c *ExpenseController) Create(ctx *gin.Context) {
 	ctx.JSON(http.StatusCreated, response)
 }
 
+func (c *ExpenseController) Update(ctx *gin.Context) {
+	id := ctx.Param("id")
+	var input dtos.UpdateExpenseRequest
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	response, err := c.UseCase.Update(ctx, id, &input)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (c *ExpenseController) Restore(ctx *gin.Context) {
+	response, err := c.UseCase.Restore(ctx, ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
 func (c *ExpenseController) Delete(ctx *gin.Context) {
 	id := ctx.Param("id")
-	if err := c.UseCase.Delete(ctx, id); err != nil {
+	var params dtos.DeleteExpenseParams
+	if err := ctx.ShouldBindQuery(&params); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := c.UseCase.Delete(ctx, id, &params); err != nil {
 		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
@@ -72,12 +105,82 @@ func (c *ExpenseController) List(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, response)
 }
 
+func (c *ExpenseController) Occurrences(ctx *gin.Context) {
+	id := ctx.Param("id")
+	var params dtos.OccurrenceParams
+	if err := ctx.ShouldBindQuery(&params); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err := params.Validate(); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	response, err := c.UseCase.Occurrences(ctx, id, &params)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (c *ExpenseController) RegisterPayment(ctx *gin.Context) {
+	id := ctx.Param("id")
+	var input dtos.CreateExpensePaymentRequest
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	response, err := c.UseCase.RegisterPayment(ctx, id, &input)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, response)
+}
+
+func (c *ExpenseController) Payments(ctx *gin.Context) {
+	response, err := c.UseCase.Payments(ctx, ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (c *ExpenseController) Overdue(ctx *gin.Context) {
+	var params dtos.OverdueParams
+	if err := ctx.ShouldBindQuery(&params); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	response, err := c.UseCase.Overdue(ctx, &params)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
 func (c *ExpenseController) RegisterRoutes(router *gin.RouterGroup) {
 	expenses := router.Group("/expenses")
 	{
 		expenses.POST("", c.Create)
+		expenses.PUT("/:id", c.Update)
 		expenses.DELETE("/:id", c.Delete)
+		expenses.POST("/:id/restore", c.Restore)
+		expenses.GET("/overdue", c.Overdue)
 		expenses.GET("/:id", c.GetByID)
+		expenses.GET("/:id/occurrences", c.Occurrences)
+		expenses.POST("/:id/payments", c.RegisterPayment)
+		expenses.GET("/:id/payments", c.Payments)
 		expenses.GET("", c.List)
 	}
 }
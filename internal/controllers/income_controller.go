@@ -60,6 +60,16 @@ func (c *IncomeController) Delete(ctx *gin.Context) {
 	ctx.Status(http.StatusNoContent)
 }
 
+func (c *IncomeController) Restore(ctx *gin.Context) {
+	response, err := c.UseCase.Restore(ctx, ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
 func (c *IncomeController) Get(ctx *gin.Context) {
 	id := ctx.Param("id")
 	response, err := c.UseCase.Get(ctx, id)
@@ -89,13 +99,36 @@ func (c *IncomeController) List(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, response)
 }
 
+func (c *IncomeController) Occurrences(ctx *gin.Context) {
+	id := ctx.Param("id")
+	var params dtos.OccurrenceParams
+	if err := ctx.ShouldBindQuery(&params); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err := params.Validate(); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	response, err := c.UseCase.Occurrences(ctx, id, &params)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
 func (c *IncomeController) RegisterRoutes(router *gin.RouterGroup) {
 	incomes := router.Group("/incomes")
 	{
 		incomes.POST("", c.Create)
 		incomes.PUT("/:id", c.Update)
 		incomes.DELETE("/:id", c.Delete)
+		incomes.POST("/:id/restore", c.Restore)
 		incomes.GET("/:id", c.Get)
+		incomes.GET("/:id/occurrences", c.Occurrences)
 		incomes.GET("", c.List)
 	}
 }
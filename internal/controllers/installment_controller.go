@@ -0,0 +1,83 @@
+package controllers
+
+import (
+	"net/http"
+
+	"financial-backend/internal/dtos"
+	"financial-backend/internal/usecases/installment"
+
+	"github.com/gin-gonic/gin"
+)
+
+type InstallmentController struct {
+	useCase installment.UseCase
+}
+
+func NewInstallmentController(useCase installment.UseCase) *InstallmentController {
+	return &InstallmentController{useCase: useCase}
+}
+
+func (c *InstallmentController) ListByExpense(ctx *gin.Context) {
+	response, err := c.useCase.ListByExpense(ctx, ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (c *InstallmentController) Pay(ctx *gin.Context) {
+	var input dtos.PayInstallmentRequest
+	if ctx.Request.ContentLength > 0 {
+		if err := ctx.ShouldBindJSON(&input); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	response, err := c.useCase.Pay(ctx, ctx.Param("id"), &input)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (c *InstallmentController) Reschedule(ctx *gin.Context) {
+	var input dtos.RescheduleInstallmentRequest
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	response, err := c.useCase.Reschedule(ctx, ctx.Param("id"), &input)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (c *InstallmentController) Cancel(ctx *gin.Context) {
+	response, err := c.useCase.Cancel(ctx, ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (c *InstallmentController) RegisterRoutes(router *gin.RouterGroup) {
+	router.GET("/expenses/:id/installments", c.ListByExpense)
+
+	installments := router.Group("/installments")
+	{
+		installments.POST("/:id/pay", c.Pay)
+		installments.POST("/:id/reschedule", c.Reschedule)
+		installments.POST("/:id/cancel", c.Cancel)
+	}
+}
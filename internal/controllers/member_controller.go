@@ -0,0 +1,91 @@
+package controllers
+
+import (
+	"net/http"
+
+	"financial-backend/internal/dtos"
+	"financial-backend/internal/usecases/member"
+
+	"github.com/gin-gonic/gin"
+)
+
+type MemberController struct {
+	useCase member.UseCase
+}
+
+func NewMemberController(useCase member.UseCase) *MemberController {
+	return &MemberController{useCase: useCase}
+}
+
+func (c *MemberController) Create(ctx *gin.Context) {
+	var input dtos.MemberRequest
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	response, err := c.useCase.Create(ctx, &input)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, response)
+}
+
+func (c *MemberController) Update(ctx *gin.Context) {
+	id := ctx.Param("id")
+	var input dtos.MemberRequest
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	response, err := c.useCase.Update(ctx, id, &input)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (c *MemberController) Delete(ctx *gin.Context) {
+	if err := c.useCase.Delete(ctx, ctx.Param("id")); err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+func (c *MemberController) Get(ctx *gin.Context) {
+	response, err := c.useCase.Get(ctx, ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (c *MemberController) List(ctx *gin.Context) {
+	response, err := c.useCase.List(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (c *MemberController) RegisterRoutes(router *gin.RouterGroup) {
+	members := router.Group("/members")
+	{
+		members.POST("", c.Create)
+		members.PUT("/:id", c.Update)
+		members.DELETE("/:id", c.Delete)
+		members.GET("/:id", c.Get)
+		members.GET("", c.List)
+	}
+}
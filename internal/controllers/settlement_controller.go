@@ -0,0 +1,71 @@
+package controllers
+
+import (
+	"net/http"
+
+	"financial-backend/internal/dtos"
+	"financial-backend/internal/usecases/settlement"
+
+	"github.com/gin-gonic/gin"
+)
+
+type SettlementController struct {
+	useCase settlement.UseCase
+}
+
+func NewSettlementController(useCase settlement.UseCase) *SettlementController {
+	return &SettlementController{useCase: useCase}
+}
+
+func (c *SettlementController) Summary(ctx *gin.Context) {
+	var params dtos.SettlementParams
+	if err := ctx.ShouldBindQuery(&params); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "parâmetros inválidos"})
+		return
+	}
+
+	response, err := c.useCase.Summary(ctx, &params)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (c *SettlementController) SettleUp(ctx *gin.Context) {
+	var input dtos.SettleUpRequest
+	if ctx.Request.ContentLength > 0 {
+		if err := ctx.ShouldBindJSON(&input); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	response, err := c.useCase.SettleUp(ctx, &input)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, response)
+}
+
+func (c *SettlementController) History(ctx *gin.Context) {
+	response, err := c.useCase.History(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (c *SettlementController) RegisterRoutes(router *gin.RouterGroup) {
+	settlements := router.Group("/settlements")
+	{
+		settlements.GET("", c.Summary)
+		settlements.POST("/settle-up", c.SettleUp)
+		settlements.GET("/history", c.History)
+	}
+}
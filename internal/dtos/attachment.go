@@ -0,0 +1,15 @@
+package dtos
+
+import "time"
+
+// AttachmentResponse representa os metadados de um anexo; checksum é o SHA-256 do conteúdo em hexadecimal
+type AttachmentResponse struct {
+	ID          string    `json:"id"`
+	OwnerType   string    `json:"owner_type"`
+	OwnerID     string    `json:"owner_id"`
+	FileName    string    `json:"file_name"`
+	ContentType string    `json:"content_type"`
+	Size        int64     `json:"size"`
+	Checksum    string    `json:"checksum"`
+	CreatedAt   time.Time `json:"created_at"`
+}
@@ -1,32 +1,76 @@
 package dtos
 
-import "time"
+import (
+	"financial-backend/pkg/money"
+	"time"
+)
 
 // CreateBudgetRequest representa a requisição para criar um orçamento
 type CreateBudgetRequest struct {
-	Description string     `json:"description" binding:"required"`
-	Amount      float64    `json:"amount" binding:"required"`
-	EndDate     *time.Time `json:"end_date"`
+	Description string      `json:"description" binding:"required"`
+	Amount      money.Money `json:"amount" binding:"required"`
+	EndDate     *time.Time  `json:"end_date"`
+	// ParentID coloca o orçamento abaixo de outro, que passa a agregar o seu valor e uso
+	ParentID *string `json:"parent_id"`
+	// Period define a renovação do orçamento: weekly, monthly (padrão), quarterly ou yearly
+	Period string `json:"period" binding:"omitempty,oneof=weekly monthly quarterly yearly"`
+	// RolloverPolicy define o destino do saldo de cada período: none (padrão), full, capped ou debt
+	RolloverPolicy string       `json:"rollover_policy"`
+	RolloverCap    *money.Money `json:"rollover_cap"`
+	// AlertThresholds são os percentuais de uso do período que disparam alertas; vazio usa o padrão da aplicação
+	AlertThresholds []int `json:"alert_thresholds"`
 }
 
 // UpdateBudgetRequest representa a requisição para atualizar um orçamento
 type UpdateBudgetRequest struct {
-	EndDate time.Time `json:"end_date" binding:"required"`
+	EndDate *time.Time `json:"end_date"`
+	// ParentID move o orçamento para baixo de outro quando informado; envie "" para torná-lo raiz
+	ParentID *string `json:"parent_id"`
+	// Amount cria uma nova versão do valor a partir do mês de effective_from (padrão: mês atual); meses anteriores não mudam
+	Amount         *money.Money `json:"amount"`
+	EffectiveFrom  *time.Time   `json:"effective_from"`
+	Reason         *string      `json:"reason"`
+	RolloverPolicy *string      `json:"rollover_policy"`
+	RolloverCap    *money.Money `json:"rollover_cap"`
+	// AlertThresholds substitui os percentuais de alerta quando informado; envie [] para voltar ao padrão
+	AlertThresholds []int `json:"alert_thresholds"`
 }
 
 // BudgetResponse representa a resposta com os dados de um orçamento
 type BudgetResponse struct {
-	ID          string     `json:"id"`
-	Description string     `json:"description"`
-	Amount      float64    `json:"amount"`
-	EndDate     *time.Time `json:"end_date"`
-	Status      string     `json:"status"`
-	CreatedAt   time.Time  `json:"created_at"`
-	UpdatedAt   time.Time  `json:"updated_at"`
+	ID          string      `json:"id"`
+	Description string      `json:"description"`
+	ParentID    *string     `json:"parent_id"`
+	Amount      money.Money `json:"amount"`
+	EndDate     *time.Time  `json:"end_date"`
+	Status      string      `json:"status"`
+	CreatedAt   time.Time   `json:"created_at"`
+	UpdatedAt   time.Time   `json:"updated_at"`
+	DeletedAt   *time.Time  `json:"deleted_at,omitempty"`
+	Period      string      `json:"period"`
+
+	RolloverPolicy string       `json:"rollover_policy"`
+	RolloverCap    *money.Money `json:"rollover_cap"`
+
+	AlertThresholds []int `json:"alert_thresholds"`
+
+	Children []BudgetResponse `json:"children"`
 }
 
 type BudgetListParams struct {
 	Description string `form:"description"`
 	Status      string `form:"status"`
+	// IncludeDeleted inclui na listagem os orçamentos excluídos
+	IncludeDeleted bool `form:"include_deleted"`
 	PageRequest
 }
+
+// BudgetAmountHistoryResponse representa um valor do orçamento e o mês a partir do qual ele vale;
+// o primeiro item é o valor com que o orçamento foi criado e não tem id
+type BudgetAmountHistoryResponse struct {
+	ID            string      `json:"id,omitempty"`
+	Amount        money.Money `json:"amount"`
+	EffectiveFrom time.Time   `json:"effective_from"`
+	Reason        *string     `json:"reason,omitempty"`
+	CreatedAt     time.Time   `json:"created_at"`
+}
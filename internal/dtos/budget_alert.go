@@ -0,0 +1,45 @@
+package dtos
+
+import (
+	"financial-backend/pkg/money"
+	"time"
+)
+
+// BudgetNotifierRequest cadastra um destino para os alertas do orçamento; target é a URL do webhook ou o e-mail
+type BudgetNotifierRequest struct {
+	Channel string `json:"channel" binding:"required,oneof=webhook smtp"`
+	Target  string `json:"target" binding:"required"`
+}
+
+// BudgetNotifierResponse representa um destino dos alertas do orçamento
+type BudgetNotifierResponse struct {
+	ID        string    `json:"id"`
+	BudgetID  string    `json:"budget_id"`
+	Channel   string    `json:"channel"`
+	Target    string    `json:"target"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// BudgetAlertResponse representa um limite de alerta já atingido pelo orçamento em um período
+type BudgetAlertResponse struct {
+	ID          string      `json:"id"`
+	BudgetID    string      `json:"budget_id"`
+	Threshold   int         `json:"threshold"`
+	PeriodStart time.Time   `json:"period_start"`
+	Limit       money.Money `json:"limit"`
+	Spent       money.Money `json:"spent"`
+	CreatedAt   time.Time   `json:"created_at"`
+}
+
+// BudgetThresholdCrossedPayload é o corpo enviado aos webhooks quando um orçamento atinge um limite de alerta
+type BudgetThresholdCrossedPayload struct {
+	Event       string      `json:"event"`
+	BudgetID    string      `json:"budget_id"`
+	Description string      `json:"description"`
+	Threshold   int         `json:"threshold"`
+	Usage       float64     `json:"usage"`
+	Limit       money.Money `json:"limit"`
+	Spent       money.Money `json:"spent"`
+	PeriodStart time.Time   `json:"period_start"`
+	PeriodEnd   time.Time   `json:"period_end"`
+}
@@ -1,14 +1,19 @@
 package dtos
 
-import "time"
+import (
+	"financial-backend/internal/models"
+	"financial-backend/pkg/money"
+	"fmt"
+	"time"
+)
 
 type BudgetMovementRequest struct {
-	BudgetId string `json:"budget_id"`
-	Origin   string `json:"origin"`
-	Month    int    `json:"month"`
-	Year     int    `json:"year"`
-	Type     string `json:"type"`
-	Amount   int    `json:"amount"`
+	BudgetId string      `json:"budget_id"`
+	Origin   string      `json:"origin"`
+	Month    int         `json:"month"`
+	Year     int         `json:"year"`
+	Type     string      `json:"type"`
+	Amount   money.Money `json:"amount"`
 }
 type BudgetMovementResponse struct {
 	ID                string         `json:"id"`
@@ -17,9 +22,36 @@ type BudgetMovementResponse struct {
 	OriginDescription *string        `json:"origin_description"`
 	Month             int            `json:"month"`
 	Year              int            `json:"year"`
+	Date              time.Time      `json:"date"`
 	Type              string         `json:"type"`
-	Amount            int            `json:"amount"`
+	Amount            money.Money    `json:"amount"`
+	Tags              []string       `json:"tags"`
 	CreatedAt         time.Time      `json:"created_at"`
+	// InstallmentID é a parcela representada pela movimentação, nas despesas parceladas
+	InstallmentID *string `json:"installment_id,omitempty"`
+	// CounterpartBudget é o orçamento do outro lado de uma transferência
+	CounterpartBudget *BudgetResponse `json:"counterpart_budget,omitempty"`
+}
+
+// BudgetTransferRequest representa a transferência de saldo do orçamento da rota para outro orçamento
+type BudgetTransferRequest struct {
+	TargetBudgetId string      `json:"target_budget_id" binding:"required"`
+	Amount         money.Money `json:"amount" binding:"required"`
+	Month          int         `json:"month" binding:"required"`
+	Year           int         `json:"year" binding:"required"`
+	Reason         *string     `json:"reason"`
+}
+
+// BudgetTransferParams permite forçar a transferência mesmo sem saldo no orçamento de origem
+type BudgetTransferParams struct {
+	Force bool `form:"force"`
+}
+
+// BudgetTransferResponse representa as duas movimentações geradas por uma transferência
+type BudgetTransferResponse struct {
+	TransferId string                 `json:"transfer_id"`
+	Debit      BudgetMovementResponse `json:"debit"`
+	Credit     BudgetMovementResponse `json:"credit"`
 }
 
 type BudgetMovementParams struct {
@@ -28,5 +60,84 @@ type BudgetMovementParams struct {
 	Origin       string `form:"origin"`
 	Month        int    `form:"month"`
 	Year         int    `form:"year"`
+	Tag          string `form:"tag"`
 	PageRequest
 }
+
+// BudgetAdjustmentRequest representa um aumento ou redução do orçamento da rota.
+// Sem to_month/to_year o ajuste vale apenas para month/year.
+type BudgetAdjustmentRequest struct {
+	Type    string      `json:"type" binding:"required,oneof=increase decrease"`
+	Amount  money.Money `json:"amount" binding:"required"`
+	Reason  string      `json:"reason" binding:"required"`
+	Month   int         `json:"month" binding:"required"`
+	Year    int         `json:"year" binding:"required"`
+	ToMonth *int        `json:"to_month"`
+	ToYear  *int        `json:"to_year"`
+}
+
+// BudgetAdjustmentResponse representa as movimentações geradas por um ajuste
+type BudgetAdjustmentResponse struct {
+	AdjustmentId string                   `json:"adjustment_id"`
+	Movements    []BudgetMovementResponse `json:"movements"`
+}
+
+// BudgetBalanceParams indica o mês do extrato; orçamentos não mensais usam o período que contém o mês
+type BudgetBalanceParams struct {
+	Month int `form:"month" binding:"required,min=1,max=12"`
+	Year  int `form:"year" binding:"required"`
+}
+
+// BudgetBalanceLine é uma movimentação do extrato com o saldo do orçamento logo depois dela
+type BudgetBalanceLine struct {
+	BudgetMovementResponse
+	Balance money.Money `json:"balance"`
+}
+
+// BudgetBalanceResponse é o extrato do orçamento no período: abertura, movimentações e fechamento
+type BudgetBalanceResponse struct {
+	BudgetID    string    `json:"budget_id"`
+	Description string    `json:"description"`
+	Period      string    `json:"period"`
+	PeriodStart time.Time `json:"period_start"`
+	PeriodEnd   time.Time `json:"period_end"`
+	// Started indica se a movimentação de início já foi gerada; sem ela, a abertura é o valor em vigor no período
+	Started   bool                `json:"started"`
+	Opening   money.Money         `json:"opening"`
+	Movements []BudgetBalanceLine `json:"movements"`
+	Closing   money.Money         `json:"closing"`
+}
+
+// Period retorna o primeiro dia do mês inicial e do mês final do ajuste. Sem to_month/to_year o ajuste
+// vale apenas para o mês informado; o período precisa estar em ordem e ter no máximo models.MaxAdjustmentMonths meses.
+func (r BudgetAdjustmentRequest) Period() (time.Time, time.Time, error) {
+	toMonth, toYear := r.Month, r.Year
+	if r.ToMonth != nil || r.ToYear != nil {
+		if r.ToMonth == nil || r.ToYear == nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("informe to_month e to_year juntos")
+		}
+		toMonth, toYear = *r.ToMonth, *r.ToYear
+	}
+
+	for _, month := range []int{r.Month, toMonth} {
+		if month < 1 || month > 12 {
+			return time.Time{}, time.Time{}, fmt.Errorf("mês inválido: %d", month)
+		}
+	}
+
+	from, _ := models.MonthRange(r.Year, time.Month(r.Month))
+	to, _ := models.MonthRange(toYear, time.Month(toMonth))
+	if to.Before(from) {
+		return time.Time{}, time.Time{}, fmt.Errorf("mês final deve ser maior ou igual ao mês inicial")
+	}
+	if models.AdjustmentMonths(from, to) > models.MaxAdjustmentMonths {
+		return time.Time{}, time.Time{}, fmt.Errorf("ajuste não pode cobrir mais de %d meses", models.MaxAdjustmentMonths)
+	}
+	return from, to, nil
+}
+
+// Validate verifica o período do ajuste antes de gravá-lo
+func (r BudgetAdjustmentRequest) Validate() error {
+	_, _, err := r.Period()
+	return err
+}
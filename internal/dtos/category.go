@@ -0,0 +1,26 @@
+package dtos
+
+import "time"
+
+// CreateCategoryRequest representa os dados necessários para criar uma categoria
+type CreateCategoryRequest struct {
+	Name     string  `json:"name" binding:"required"`
+	ParentID *string `json:"parent_id"`
+}
+
+// UpdateCategoryRequest representa os dados necessários para atualizar uma categoria.
+// Para tornar a categoria raiz, envie parent_id vazio.
+type UpdateCategoryRequest struct {
+	Name     *string `json:"name"`
+	ParentID *string `json:"parent_id"`
+}
+
+// CategoryResponse representa uma categoria com as suas subcategorias
+type CategoryResponse struct {
+	ID        string             `json:"id"`
+	Name      string             `json:"name"`
+	ParentID  *string            `json:"parent_id"`
+	Children  []CategoryResponse `json:"children"`
+	CreatedAt time.Time          `json:"created_at"`
+	UpdatedAt time.Time          `json:"updated_at"`
+}
@@ -0,0 +1,82 @@
+package dtos
+
+import (
+	"financial-backend/pkg/money"
+	"time"
+)
+
+// CreateCreditCardRequest representa a requisição para criar um cartão de crédito
+type CreateCreditCardRequest struct {
+	Name       string      `json:"name" binding:"required"`
+	ClosingDay int         `json:"closing_day" binding:"required"`
+	DueDay     int         `json:"due_day" binding:"required"`
+	Limit      money.Money `json:"limit"`
+}
+
+// UpdateCreditCardRequest representa a requisição para atualizar um cartão de crédito
+type UpdateCreditCardRequest struct {
+	Name       *string      `json:"name"`
+	ClosingDay *int         `json:"closing_day"`
+	DueDay     *int         `json:"due_day"`
+	Limit      *money.Money `json:"limit"`
+}
+
+// CreditCardResponse representa a resposta com os dados de um cartão de crédito
+type CreditCardResponse struct {
+	ID         string      `json:"id"`
+	Name       string      `json:"name"`
+	ClosingDay int         `json:"closing_day"`
+	DueDay     int         `json:"due_day"`
+	Limit      money.Money `json:"limit"`
+	CreatedAt  time.Time   `json:"created_at"`
+	UpdatedAt  time.Time   `json:"updated_at"`
+}
+
+type CreditCardListParams struct {
+	Name string `form:"name"`
+	PageRequest
+}
+
+// StatementParams representa a fatura (ano e mês de vencimento) na rota
+type StatementParams struct {
+	Year  int `uri:"year" binding:"required"`
+	Month int `uri:"month" binding:"required,min=1,max=12"`
+}
+
+// PayStatementRequest representa o pagamento de uma fatura; sem valor, paga o total em aberto
+type PayStatementRequest struct {
+	Amount *money.Money `json:"amount"`
+	PaidAt *time.Time   `json:"paid_at"`
+}
+
+type CreditCardStatementItemResponse struct {
+	ExpenseID        string      `json:"expense_id"`
+	Description      string      `json:"description"`
+	Installment      int         `json:"installment"`
+	Installments     *int        `json:"installments"`
+	InstallmentLabel string      `json:"installment_label,omitempty"`
+	Amount           money.Money `json:"amount"`
+	DueDate          time.Time   `json:"due_date"`
+	Paid             bool        `json:"paid"`
+}
+
+type CreditCardPaymentResponse struct {
+	ID     string      `json:"id"`
+	Amount money.Money `json:"amount"`
+	PaidAt time.Time   `json:"paid_at"`
+}
+
+// CreditCardStatementResponse representa a fatura de um cartão
+type CreditCardStatementResponse struct {
+	CardID      string                            `json:"card_id"`
+	CardName    string                            `json:"card_name"`
+	Year        int                               `json:"year"`
+	Month       int                               `json:"month"`
+	ClosingDate time.Time                         `json:"closing_date"`
+	DueDate     time.Time                         `json:"due_date"`
+	Total       money.Money                       `json:"total"`
+	PaidAmount  money.Money                       `json:"paid_amount"`
+	Paid        bool                              `json:"paid"`
+	Items       []CreditCardStatementItemResponse `json:"items"`
+	Payments    []CreditCardPaymentResponse       `json:"payments"`
+}
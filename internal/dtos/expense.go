@@ -1,28 +1,59 @@
 package dtos
 
 import (
+	"financial-backend/pkg/money"
 	"time"
 )
 
+// ExpenseAllocationDTO representa a parte da despesa destinada a um orçamento; informe amount ou percentage
+type ExpenseAllocationDTO struct {
+	BudgetID   string       `json:"budget_id" binding:"required"`
+	Amount     *money.Money `json:"amount"`
+	Percentage *float64     `json:"percentage"`
+}
+
+// ExpenseShareDTO representa a parte de um membro na despesa; amount é usado na divisão exata e percentage na por percentual
+type ExpenseShareDTO struct {
+	MemberID   string       `json:"member_id" binding:"required"`
+	Amount     *money.Money `json:"amount"`
+	Percentage *float64     `json:"percentage"`
+}
+
+// ExpenseSplitDTO indica quem pagou a despesa e como ela é dividida entre os membros
+type ExpenseSplitDTO struct {
+	PaidBy string            `json:"paid_by"`
+	Type   string            `json:"type"`
+	Shares []ExpenseShareDTO `json:"shares"`
+}
+
 // ExpenseDTO representa os dados necessários para criar uma despesa
 type ExpenseDTO struct {
-	Description  string         `json:"description" binding:"required"`
-	Amount       float64        `json:"amount" binding:"required"`
-	Type         string         `json:"type" binding:"required"`
-	BudgetID     *string        `json:"budget_id"`
+	Description  string          `json:"description" binding:"required"`
+	Amount       money.Money     `json:"amount" binding:"required"`
+	Type         string          `json:"type" binding:"required"`
+	BudgetID     *string         `json:"budget_id"`
+	CardID       *string         `json:"card_id"`
+	CategoryID   *string         `json:"category_id"`
 	Budget       *BudgetResponse `json:"budget"`
-	Recurrency   *string        `json:"recurrency"`
-	Method       string         `json:"method" binding:"required"`
-	Installments *int           `json:"installments"`
-	DueDay       int            `json:"due_day" binding:"required"`
-	StartDate    time.Time      `json:"start_date" binding:"required"`
-	EndDate      *time.Time     `json:"end_date"`
+	Recurrency   *string         `json:"recurrency"`
+	Rrule        *string         `json:"rrule"`
+	Method       string          `json:"method" binding:"required"`
+	Installments *int            `json:"installments"`
+	DueDay       int             `json:"due_day" binding:"required"`
+	StartDate    time.Time       `json:"start_date" binding:"required"`
+	EndDate      *time.Time      `json:"end_date"`
+	Tags         []string        `json:"tags"`
+	// Allocations divide a despesa entre orçamentos; a soma deve ser igual ao valor da despesa
+	Allocations []ExpenseAllocationDTO `json:"allocations"`
+	// Split indica quem pagou e quem se beneficia da despesa compartilhada
+	Split *ExpenseSplitDTO `json:"split"`
 }
 
 // ExpenseResponse representa os dados retornados de uma despesa
 type ExpenseResponse struct {
 	ID string `json:"id"`
 	ExpenseDTO
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 }
 
 // ListExpensesResponse representa a resposta da listagem de despesas
@@ -33,23 +64,48 @@ type ListExpensesResponse struct {
 
 // UpdateExpenseRequest representa os dados necessários para atualizar uma despesa
 type UpdateExpenseRequest struct {
-	Description  *string    `json:"description"`
-	Amount       *float64   `json:"amount"`
-	Category     *string    `json:"category"`
+	Description *string      `json:"description"`
+	Amount      *money.Money `json:"amount"`
+	Type        *string      `json:"type"`
+	// BudgetID troca o orçamento da despesa; envie "" para remover. Com alocações, deve ser o da primeira alocação
+	BudgetID *string `json:"budget_id"`
+	CardID   *string `json:"card_id"`
+	// Recurrency troca a recorrência e remove a rrule atual, a menos que uma nova seja enviada
+	Recurrency *string `json:"recurrency"`
+	// Rrule troca a regra da recorrência personalizada; envie "" para remover
+	Rrule        *string    `json:"rrule"`
+	Method       *string    `json:"method"`
+	CategoryID   *string    `json:"category_id"`
 	StartDate    *time.Time `json:"start_date"`
 	EndDate      *time.Time `json:"end_date"`
 	Installments *int       `json:"installments"`
+	DueDay       *int       `json:"due_day"`
 	DueDate      *time.Time `json:"due_date"`
 	StatementDay *int       `json:"statement_day"`
+	// Tags substitui as tags da despesa quando informado; envie [] para remover todas
+	Tags []string `json:"tags"`
+	// Allocations substitui a divisão entre orçamentos quando informado; envie [] para voltar a um único orçamento
+	Allocations []ExpenseAllocationDTO `json:"allocations"`
+	// Split substitui a divisão entre membros quando informado; envie paid_by vazio para deixar de compartilhar
+	Split *ExpenseSplitDTO `json:"split"`
 }
 
 // ListExpensesRequest representa os parâmetros para listar despesas
 type ListExpensesRequest struct {
 	Description string `form:"description"`
 	Type        string `form:"type"`
-	Category    string `form:"category"`
+	CategoryID  string `form:"category_id"`
 	BudgetID    string `form:"budget_id"`
 	Recurrency  string `form:"recurrency"`
 	Method      string `form:"method"`
+	Tag         string `form:"tag"`
+	// IncludeDeleted inclui na listagem as despesas excluídas
+	IncludeDeleted bool `form:"include_deleted"`
 	PageRequest
 }
+
+// DeleteExpenseParams representa as opções de estorno ao excluir uma despesa
+type DeleteExpenseParams struct {
+	Strategy       string `form:"strategy"`
+	KeepPastMonths bool   `form:"keep_past_months"`
+}
@@ -0,0 +1,29 @@
+package dtos
+
+import (
+	"financial-backend/pkg/money"
+	"time"
+)
+
+// ExpenseInstallmentResponse representa uma parcela de uma despesa
+type ExpenseInstallmentResponse struct {
+	ID        string      `json:"id"`
+	ExpenseID string      `json:"expense_id"`
+	Number    int         `json:"number"`
+	DueDate   time.Time   `json:"due_date"`
+	Amount    money.Money `json:"amount"`
+	Status    string      `json:"status"`
+	PaidAt    *time.Time  `json:"paid_at"`
+	// Rescheduled indica que o vencimento foi alterado à mão
+	Rescheduled bool `json:"rescheduled"`
+}
+
+// PayInstallmentRequest representa os dados para pagar uma parcela; sem data, usa o momento atual
+type PayInstallmentRequest struct {
+	PaidAt *time.Time `json:"paid_at"`
+}
+
+// RescheduleInstallmentRequest representa os dados para reagendar uma parcela
+type RescheduleInstallmentRequest struct {
+	DueDate time.Time `json:"due_date" binding:"required"`
+}
@@ -0,0 +1,41 @@
+package dtos
+
+import (
+	"financial-backend/pkg/money"
+	"time"
+)
+
+// CreateExpensePaymentRequest representa o pagamento de uma ocorrência da despesa.
+// Sem valor, considera o valor da ocorrência; sem data de pagamento, o momento atual.
+type CreateExpensePaymentRequest struct {
+	OccurrenceDate time.Time    `json:"occurrence_date" binding:"required"`
+	Amount         *money.Money `json:"amount"`
+	PaidAt         *time.Time   `json:"paid_at"`
+	Note           *string      `json:"note"`
+}
+
+// ExpensePaymentResponse representa um pagamento registrado para uma ocorrência da despesa
+type ExpensePaymentResponse struct {
+	ID             string      `json:"id"`
+	ExpenseID      string      `json:"expense_id"`
+	OccurrenceDate time.Time   `json:"occurrence_date"`
+	Amount         money.Money `json:"amount"`
+	PaidAt         time.Time   `json:"paid_at"`
+	Note           *string     `json:"note"`
+}
+
+// OverdueParams representa o início da busca por despesas vencidas
+type OverdueParams struct {
+	From time.Time `form:"from" time_format:"2006-01-02"`
+}
+
+// OverdueExpenseResponse representa uma ocorrência vencida e sem pagamento
+type OverdueExpenseResponse struct {
+	ExpenseID   string      `json:"expense_id"`
+	Description string      `json:"description"`
+	Method      string      `json:"method"`
+	Number      int         `json:"number"`
+	DueDate     time.Time   `json:"due_date"`
+	Amount      money.Money `json:"amount"`
+	DaysOverdue int         `json:"days_overdue"`
+}
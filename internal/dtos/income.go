@@ -1,41 +1,59 @@
 package dtos
 
-import "time"
+import (
+	"financial-backend/pkg/money"
+	"time"
+)
 
 // IncomeResponse representa a estrutura de resposta para receitas
 type IncomeResponse struct {
-	ID          string     `json:"id"`
-	Description string     `json:"description"`
-	Amount      float64    `json:"amount"`
-	Type        string     `json:"type"`
-	DueDay      int        `json:"due_day"`
-	StartDate   time.Time  `json:"start_date"`
-	EndDate     *time.Time `json:"end_date"`
-	CreatedAt   time.Time  `json:"created_at"`
-	UpdatedAt   time.Time  `json:"updated_at"`
+	ID          string      `json:"id"`
+	Description string      `json:"description"`
+	Amount      money.Money `json:"amount"`
+	Type        string      `json:"type"`
+	DueDay      int         `json:"due_day"`
+	Rrule       *string     `json:"rrule"`
+	CategoryID  *string     `json:"category_id"`
+	StartDate   time.Time   `json:"start_date"`
+	EndDate     *time.Time  `json:"end_date"`
+	Tags        []string    `json:"tags"`
+	CreatedAt   time.Time   `json:"created_at"`
+	UpdatedAt   time.Time   `json:"updated_at"`
+	DeletedAt   *time.Time  `json:"deleted_at,omitempty"`
 }
 
 // CreateIncomeRequest representa a requisição para criar uma receita
 type CreateIncomeRequest struct {
-	Description string     `json:"description" binding:"required"`
-	Amount      float64    `json:"amount" binding:"required"`
-	Type        string     `json:"type" binding:"required"`
-	DueDay      int        `json:"due_day" binding:"required"`
-	StartDate   time.Time  `json:"start_date" binding:"required"`
-	EndDate     *time.Time `json:"end_date"`
+	Description string      `json:"description" binding:"required"`
+	Amount      money.Money `json:"amount" binding:"required"`
+	Type        string      `json:"type" binding:"required"`
+	DueDay      int         `json:"due_day" binding:"required"`
+	Rrule       *string     `json:"rrule"`
+	CategoryID  *string     `json:"category_id"`
+	StartDate   time.Time   `json:"start_date" binding:"required"`
+	EndDate     *time.Time  `json:"end_date"`
+	Tags        []string    `json:"tags"`
 }
 
 type ListIncomeParams struct {
 	Type        string `form:"type"`
 	Description string `form:"description"`
+	CategoryID  string `form:"category_id"`
+	Tag         string `form:"tag"`
+	// IncludeDeleted inclui na listagem as receitas excluídas
+	IncludeDeleted bool `form:"include_deleted"`
 	PageRequest
 }
 
 // UpdateIncomeRequest representa a requisição para atualizar uma receita
 type UpdateIncomeRequest struct {
-	Description *string    `json:"description"`
-	Amount      *float64   `json:"amount"`
-	Type        *string    `json:"type"`
-	Category    *string    `json:"category"`
-	Date        *time.Time `json:"date"`
+	Description *string      `json:"description"`
+	Amount      *money.Money `json:"amount"`
+	Type        *string      `json:"type"`
+	CategoryID  *string      `json:"category_id"`
+	Date        *time.Time   `json:"date"`
+	DueDay      *int         `json:"due_day"`
+	EndDate     *time.Time   `json:"end_date"`
+	// Tags substitui as tags da receita quando informado; envie [] para remover todas
+	Tags []string `json:"tags"`
 }
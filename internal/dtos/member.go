@@ -0,0 +1,16 @@
+package dtos
+
+import "time"
+
+// MemberRequest representa os dados necessários para criar ou renomear um membro
+type MemberRequest struct {
+	Name string `json:"name" binding:"required"`
+}
+
+// MemberResponse representa um membro da casa
+type MemberResponse struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
@@ -0,0 +1,54 @@
+package dtos
+
+import (
+	"errors"
+	"financial-backend/pkg/money"
+	"fmt"
+	"time"
+)
+
+// MaxOccurrenceSpanYears limita o intervalo consultado, já que despesas diárias geram uma ocorrência por dia
+const MaxOccurrenceSpanYears = 2
+
+// OccurrenceParams representa o intervalo de datas usado no cálculo das ocorrências
+type OccurrenceParams struct {
+	From time.Time `form:"from" time_format:"2006-01-02"`
+	To   time.Time `form:"to" time_format:"2006-01-02"`
+}
+
+// Range retorna o intervalo informado; sem datas, considera o mês atual.
+// O intervalo precisa estar em ordem e não pode passar de MaxOccurrenceSpanYears anos.
+func (p OccurrenceParams) Range() (time.Time, time.Time, error) {
+	from := p.From
+	if from.IsZero() {
+		now := time.Now()
+		from = time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	}
+
+	to := p.To
+	if to.IsZero() {
+		to = from.AddDate(0, 1, -1)
+	}
+
+	if to.Before(from) {
+		return time.Time{}, time.Time{}, errors.New("data final deve ser maior ou igual à data inicial")
+	}
+	if to.After(from.AddDate(MaxOccurrenceSpanYears, 0, 0)) {
+		return time.Time{}, time.Time{}, fmt.Errorf("intervalo de ocorrências não pode passar de %d anos", MaxOccurrenceSpanYears)
+	}
+	return from, to, nil
+}
+
+// Validate verifica o intervalo informado antes da consulta
+func (p OccurrenceParams) Validate() error {
+	_, _, err := p.Range()
+	return err
+}
+
+// OccurrenceResponse representa uma ocorrência de despesa ou receita
+type OccurrenceResponse struct {
+	Number int         `json:"number"`
+	Total  *int        `json:"total,omitempty"`
+	Date   time.Time   `json:"date"`
+	Amount money.Money `json:"amount"`
+}
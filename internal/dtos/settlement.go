@@ -0,0 +1,52 @@
+package dtos
+
+import (
+	"financial-backend/pkg/money"
+	"time"
+)
+
+// MemberRef identifica um membro nas respostas de acerto de contas
+type MemberRef struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// MemberBalanceResponse representa a posição de um membro; balance positivo indica valor a receber
+type MemberBalanceResponse struct {
+	Member  MemberRef   `json:"member"`
+	Paid    money.Money `json:"paid"`
+	Share   money.Money `json:"share"`
+	Balance money.Money `json:"balance"`
+}
+
+// TransferResponse representa um pagamento necessário para quitar os saldos
+type TransferResponse struct {
+	From   MemberRef   `json:"from"`
+	To     MemberRef   `json:"to"`
+	Amount money.Money `json:"amount"`
+}
+
+// SettlementSummaryResponse representa quem deve a quem após a compensação dos saldos
+type SettlementSummaryResponse struct {
+	Balances  []MemberBalanceResponse `json:"balances"`
+	Transfers []TransferResponse      `json:"transfers"`
+}
+
+// SettlementParams representa os parâmetros do acerto de contas; sem date, considera até hoje
+type SettlementParams struct {
+	Date *time.Time `form:"date" time_format:"2006-01-02"`
+}
+
+// SettleUpRequest representa o registro dos reembolsos sugeridos no acerto de contas
+type SettleUpRequest struct {
+	SettledAt *time.Time `json:"settled_at"`
+}
+
+// SettlementResponse representa um reembolso registrado
+type SettlementResponse struct {
+	ID        string      `json:"id"`
+	From      MemberRef   `json:"from"`
+	To        MemberRef   `json:"to"`
+	Amount    money.Money `json:"amount"`
+	SettledAt time.Time   `json:"settled_at"`
+}
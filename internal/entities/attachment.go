@@ -0,0 +1,16 @@
+package entities
+
+import "time"
+
+// Attachment representa a tabela de anexos (comprovantes, boletos, notas) de despesas e receitas
+type Attachment struct {
+	ID          string    `gorm:"primaryKey"`
+	OwnerType   string    `gorm:"index:idx_attachment_owner;not null"`
+	OwnerID     string    `gorm:"index:idx_attachment_owner;not null"`
+	FileName    string    `gorm:"not null"`
+	ContentType string    `gorm:"not null"`
+	Size        int64     `gorm:"not null"`
+	Checksum    string    `gorm:"not null"`
+	StorageKey  string    `gorm:"not null"`
+	CreatedAt   time.Time `gorm:"not null"`
+}
@@ -1,16 +1,40 @@
 package entities
 
 import (
+	"financial-backend/pkg/money"
 	"time"
+
+	"gorm.io/gorm"
 )
 
 // Budget representa a tabela de orçamentos
 type Budget struct {
-	ID          string     `gorm:"primaryKey"`
-	Description string     `gorm:"not null"`
-	Amount      float64    `gorm:"not null"`
-	EndDate     *time.Time `gorm:"null"`
-	CreatedAt   time.Time  `gorm:"not null"`
-	UpdatedAt   time.Time  `gorm:"not null"`
-	Expenses    []Expense  `gorm:"foreignKey:BudgetID"`
+	ID          string         `gorm:"primaryKey"`
+	Description string         `gorm:"not null"`
+	Amount      money.Money    `gorm:"type:numeric(15,2);not null"`
+	EndDate     *time.Time     `gorm:"null"`
+	CreatedAt   time.Time      `gorm:"not null"`
+	UpdatedAt   time.Time      `gorm:"not null"`
+	DeletedAt   gorm.DeletedAt `gorm:"index"`
+	// ParentID liga o orçamento ao orçamento pai, que agrega o valor e o uso dos filhos
+	ParentID *string `gorm:"index"`
+	// Period define a renovação do orçamento: weekly, monthly, quarterly ou yearly
+	Period string `gorm:"not null;default:monthly"`
+	// RolloverPolicy define o destino do saldo do período anterior: none, full, capped ou debt
+	RolloverPolicy string       `gorm:"not null;default:none"`
+	RolloverCap    *money.Money `gorm:"type:numeric(15,2)"`
+	// AlertThresholds guarda os percentuais de alerta separados por vírgula, por exemplo "50,80,100"
+	AlertThresholds string
+	Expenses        []Expense             `gorm:"foreignKey:BudgetID"`
+	AmountVersions  []BudgetAmountVersion `gorm:"foreignKey:BudgetID"`
+}
+
+// BudgetAmountVersion representa a tabela de versões do valor de um orçamento
+type BudgetAmountVersion struct {
+	ID            string      `gorm:"primaryKey"`
+	BudgetID      string      `gorm:"not null;index"`
+	Amount        money.Money `gorm:"type:numeric(15,2);not null"`
+	EffectiveFrom time.Time   `gorm:"type:date;not null"`
+	Reason        *string
+	CreatedAt     time.Time `gorm:"not null"`
 }
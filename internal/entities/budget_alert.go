@@ -0,0 +1,26 @@
+package entities
+
+import (
+	"financial-backend/pkg/money"
+	"time"
+)
+
+// BudgetNotifier representa a tabela de destinos dos alertas de um orçamento
+type BudgetNotifier struct {
+	ID        string    `gorm:"primaryKey"`
+	BudgetID  string    `gorm:"not null;index"`
+	Channel   string    `gorm:"not null"`
+	Target    string    `gorm:"not null"`
+	CreatedAt time.Time `gorm:"not null"`
+}
+
+// BudgetAlert representa a tabela de alertas já disparados; o índice único garante um disparo por limite e período
+type BudgetAlert struct {
+	ID          string      `gorm:"primaryKey"`
+	BudgetID    string      `gorm:"not null;uniqueIndex:idx_budget_alert_period"`
+	Threshold   int         `gorm:"not null;uniqueIndex:idx_budget_alert_period"`
+	PeriodStart time.Time   `gorm:"type:date;not null;uniqueIndex:idx_budget_alert_period"`
+	Limit       money.Money `gorm:"type:numeric(15,2);not null"`
+	Spent       money.Money `gorm:"type:numeric(15,2);not null"`
+	CreatedAt   time.Time   `gorm:"not null"`
+}
@@ -1,20 +1,33 @@
 package entities
 
 import (
+	"financial-backend/pkg/money"
 	"time"
 )
 
 type BudgetMovement struct {
-	ID        string `gorm:"primaryKey"`
-	BudgetId  string `gorm:"not null"`
-	Budget    Budget `gorm:"foreignKey:BudgetId"`
-	Origin    string
-	Month     int
-	Year      int
+	ID       string `gorm:"primaryKey"`
+	BudgetId string `gorm:"not null"`
+	Budget   Budget `gorm:"foreignKey:BudgetId"`
+	Origin   string
+	Month    int
+	Year     int
+	// Date é o dia a que a movimentação se refere (vencimento da ocorrência ou da parcela); decide a semana
+	// nos orçamentos semanais. Vazia nas movimentações antigas, que usam CreatedAt
+	Date      *time.Time `gorm:"type:date"`
 	Type      string
-	Amount    int
+	Amount    money.Money `gorm:"type:numeric(15,2)"`
+	Tags      []Tag       `gorm:"many2many:budget_movement_tags"`
 	CreatedAt time.Time
 
+	// InstallmentID liga a movimentação de uma despesa parcelada à parcela que ela representa
+	InstallmentID *string `gorm:"index"`
+
+	// campos das transferências e ajustes de orçamento; Origin guarda o id da transferência ou do ajuste
+	CounterpartBudgetId *string `gorm:"index"`
+	CounterpartBudget   *Budget `gorm:"foreignKey:CounterpartBudgetId"`
+	Reason              *string
+
 	// field for read
 	OriginDescription *string `gorm:"->;-:migration"`
 }
@@ -0,0 +1,12 @@
+package entities
+
+import "time"
+
+// Category representa a tabela de categorias de despesas e receitas
+type Category struct {
+	ID        string    `gorm:"primaryKey"`
+	Name      string    `gorm:"not null"`
+	ParentID  *string   `gorm:"index"`
+	CreatedAt time.Time `gorm:"not null"`
+	UpdatedAt time.Time `gorm:"not null"`
+}
@@ -0,0 +1,17 @@
+package entities
+
+import (
+	"financial-backend/pkg/money"
+	"time"
+)
+
+// CreditCard representa a tabela de cartões de crédito
+type CreditCard struct {
+	ID         string      `gorm:"primaryKey"`
+	Name       string      `gorm:"not null"`
+	ClosingDay int         `gorm:"not null"`
+	DueDay     int         `gorm:"not null"`
+	Limit      money.Money `gorm:"type:numeric(15,2);not null"`
+	CreatedAt  time.Time   `gorm:"not null"`
+	UpdatedAt  time.Time   `gorm:"not null"`
+}
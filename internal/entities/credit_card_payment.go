@@ -0,0 +1,17 @@
+package entities
+
+import (
+	"financial-backend/pkg/money"
+	"time"
+)
+
+// CreditCardPayment representa a tabela de pagamentos de faturas de cartão
+type CreditCardPayment struct {
+	ID        string      `gorm:"primaryKey"`
+	CardID    string      `gorm:"index;not null"`
+	Year      int         `gorm:"not null"`
+	Month     int         `gorm:"not null"`
+	Amount    money.Money `gorm:"type:numeric(15,2);not null"`
+	PaidAt    time.Time   `gorm:"not null"`
+	CreatedAt time.Time   `gorm:"not null"`
+}
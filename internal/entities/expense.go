@@ -1,23 +1,35 @@
 package entities
 
 import (
+	"financial-backend/pkg/money"
 	"time"
+
+	"gorm.io/gorm"
 )
 
 // Expense representa a tabela de despesas
 type Expense struct {
-	ID           string  `gorm:"primaryKey"`
-	Description  string  `gorm:"not null"`
-	Amount       float64 `gorm:"not null"`
-	Type         string  `gorm:"not null"`
-	BudgetID     *string `gorm:"index"`
+	ID           string      `gorm:"primaryKey"`
+	Description  string      `gorm:"not null"`
+	Amount       money.Money `gorm:"type:numeric(15,2);not null"`
+	Type         string      `gorm:"not null"`
+	BudgetID     *string     `gorm:"index"`
 	Budget       *Budget
+	CardID       *string `gorm:"index"`
+	CategoryID   *string `gorm:"index"`
+	Tags         []Tag   `gorm:"many2many:expense_tags"`
+	Allocations  []ExpenseAllocation
+	PaidByID     *string `gorm:"index"`
+	SplitType    *string
+	Shares       []ExpenseShare
 	Recurrency   *string
+	Rrule        *string
 	Method       string
 	Installments *int
 	StartDate    time.Time
 	DueDay       int
 	EndDate      *time.Time
-	CreatedAt    time.Time `gorm:"not null"`
-	UpdatedAt    time.Time `gorm:"not null"`
+	CreatedAt    time.Time      `gorm:"not null"`
+	UpdatedAt    time.Time      `gorm:"not null"`
+	DeletedAt    gorm.DeletedAt `gorm:"index"`
 }
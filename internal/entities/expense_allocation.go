@@ -0,0 +1,12 @@
+package entities
+
+import "financial-backend/pkg/money"
+
+// ExpenseAllocation representa a tabela de divisão de despesas entre orçamentos
+type ExpenseAllocation struct {
+	ID         string      `gorm:"primaryKey"`
+	ExpenseID  string      `gorm:"index;not null"`
+	BudgetID   string      `gorm:"index;not null"`
+	Amount     money.Money `gorm:"type:numeric(15,2);not null"`
+	Percentage *float64    `gorm:"type:numeric(5,2)"`
+}
@@ -0,0 +1,23 @@
+package entities
+
+import (
+	"financial-backend/pkg/money"
+	"time"
+)
+
+// ExpenseInstallment representa a tabela de parcelas de despesas
+type ExpenseInstallment struct {
+	ID        string      `gorm:"primaryKey"`
+	ExpenseID string      `gorm:"index;not null"`
+	Number    int         `gorm:"not null"`
+	DueDate   time.Time   `gorm:"not null"`
+	Amount    money.Money `gorm:"type:numeric(15,2);not null"`
+	Status    string      `gorm:"not null"`
+	PaidAt    *time.Time
+	// Rescheduled marca as parcelas com vencimento alterado à mão, mantidas quando a despesa é alterada
+	Rescheduled bool `gorm:"not null;default:false"`
+	// CancelledWithExpense marca as parcelas canceladas pela exclusão da despesa, reabertas se ela for restaurada
+	CancelledWithExpense bool      `gorm:"not null;default:false"`
+	CreatedAt            time.Time `gorm:"not null"`
+	UpdatedAt            time.Time `gorm:"not null"`
+}
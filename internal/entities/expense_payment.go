@@ -0,0 +1,24 @@
+package entities
+
+import (
+	"financial-backend/pkg/money"
+	"time"
+)
+
+// ExpensePayment representa a tabela de pagamentos das ocorrências de despesas
+type ExpensePayment struct {
+	ID             string      `gorm:"primaryKey"`
+	ExpenseID      string      `gorm:"uniqueIndex:idx_expense_payment_occurrence;not null"`
+	OccurrenceDate time.Time   `gorm:"type:date;uniqueIndex:idx_expense_payment_occurrence;not null"`
+	Amount         money.Money `gorm:"type:numeric(15,2);not null"`
+	PaidAt         time.Time   `gorm:"not null"`
+	Note           *string
+	CreatedAt      time.Time `gorm:"not null"`
+}
+
+// ExpenseOverdueNotice registra as ocorrências já notificadas como vencidas, para que ExpenseOverdue saia uma única vez
+type ExpenseOverdueNotice struct {
+	ExpenseID      string    `gorm:"primaryKey"`
+	OccurrenceDate time.Time `gorm:"type:date;primaryKey"`
+	CreatedAt      time.Time `gorm:"not null"`
+}
@@ -0,0 +1,12 @@
+package entities
+
+import "financial-backend/pkg/money"
+
+// ExpenseShare representa a tabela de partes de uma despesa dividida entre membros
+type ExpenseShare struct {
+	ID         string      `gorm:"primaryKey"`
+	ExpenseID  string      `gorm:"index;not null"`
+	MemberID   string      `gorm:"index;not null"`
+	Amount     money.Money `gorm:"type:numeric(15,2);not null"`
+	Percentage *float64    `gorm:"type:numeric(5,2)"`
+}
@@ -1,18 +1,25 @@
 package entities
 
 import (
+	"financial-backend/pkg/money"
 	"time"
+
+	"gorm.io/gorm"
 )
 
 // Income representa a tabela de receitas
 type Income struct {
-	ID          string     `json:"id" gorm:"primaryKey"`
-	Description string     `json:"description"`
-	Amount      float64    `json:"amount"`
-	Type        string     `json:"type"`
-	StartDate   time.Time  `json:"start_date"`
-	DueDay      int        `json:"due_day"`
-	EndDate     *time.Time `json:"end_date"`
-	CreatedAt   time.Time  `json:"created_at"`
-	UpdatedAt   time.Time  `json:"updated_at"`
+	ID          string         `json:"id" gorm:"primaryKey"`
+	Description string         `json:"description"`
+	Amount      money.Money    `json:"amount" gorm:"type:numeric(15,2)"`
+	Type        string         `json:"type"`
+	StartDate   time.Time      `json:"start_date"`
+	DueDay      int            `json:"due_day"`
+	Rrule       *string        `json:"rrule"`
+	CategoryID  *string        `json:"category_id" gorm:"index"`
+	Tags        []Tag          `json:"tags" gorm:"many2many:income_tags"`
+	EndDate     *time.Time     `json:"end_date"`
+	CreatedAt   time.Time      `json:"created_at"`
+	UpdatedAt   time.Time      `json:"updated_at"`
+	DeletedAt   gorm.DeletedAt `json:"deleted_at" gorm:"index"`
 }
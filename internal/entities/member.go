@@ -0,0 +1,11 @@
+package entities
+
+import "time"
+
+// Member representa a tabela de membros da casa que dividem despesas
+type Member struct {
+	ID        string    `gorm:"primaryKey"`
+	Name      string    `gorm:"not null"`
+	CreatedAt time.Time `gorm:"not null"`
+	UpdatedAt time.Time `gorm:"not null"`
+}
@@ -0,0 +1,16 @@
+package entities
+
+import (
+	"financial-backend/pkg/money"
+	"time"
+)
+
+// Settlement representa a tabela de reembolsos entre membros
+type Settlement struct {
+	ID           string      `gorm:"primaryKey"`
+	FromMemberID string      `gorm:"index;not null"`
+	ToMemberID   string      `gorm:"index;not null"`
+	Amount       money.Money `gorm:"type:numeric(15,2);not null"`
+	SettledAt    time.Time   `gorm:"not null"`
+	CreatedAt    time.Time   `gorm:"not null"`
+}
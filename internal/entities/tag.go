@@ -0,0 +1,6 @@
+package entities
+
+// Tag representa a tabela de tags livres usadas em despesas, receitas e movimentações
+type Tag struct {
+	Name string `gorm:"primaryKey"`
+}
@@ -0,0 +1,100 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"financial-backend/internal/dtos"
+	"financial-backend/internal/models"
+	"financial-backend/internal/models/events"
+	"financial-backend/pkg/config"
+	"financial-backend/pkg/notifier"
+)
+
+type BudgetAdjustedHandler struct{}
+
+func NewBudgetAdjustedHandler() *BudgetAdjustedHandler {
+	return &BudgetAdjustedHandler{}
+}
+
+func (h *BudgetAdjustedHandler) EventName() string {
+	return "BudgetAdjusted"
+}
+
+func (h *BudgetAdjustedHandler) Handle(e config.Event) {
+	event := e.(*events.BudgetAdjustedEvent)
+	for _, movement := range event.Movements {
+		log.Printf("orçamento %s (%s) ajustado em %s em %02d/%d: %s",
+			event.Budget.ID(),
+			event.Budget.Description(),
+			movement.Amount(),
+			movement.Month(),
+			movement.Year(),
+			event.Reason,
+		)
+	}
+}
+
+// BudgetThresholdCrossedHandler entrega o alerta de limite a cada notificador cadastrado no orçamento
+type BudgetThresholdCrossedHandler struct {
+	notifiers map[models.NotifierChannel]notifier.Notifier
+}
+
+func NewBudgetThresholdCrossedHandler(notifiers map[models.NotifierChannel]notifier.Notifier) *BudgetThresholdCrossedHandler {
+	return &BudgetThresholdCrossedHandler{notifiers: notifiers}
+}
+
+func (h *BudgetThresholdCrossedHandler) EventName() string {
+	return "BudgetThresholdCrossed"
+}
+
+func (h *BudgetThresholdCrossedHandler) Handle(e config.Event) {
+	event := e.(*events.BudgetThresholdCrossedEvent)
+	alert := event.Alert
+	usage := models.UsagePercent(alert.Limit(), alert.Spent())
+
+	log.Printf("orçamento %s (%s) atingiu %d%% do limite: %s de %s",
+		event.Budget.ID(),
+		event.Budget.Description(),
+		alert.Threshold(),
+		alert.Spent(),
+		alert.Limit(),
+	)
+
+	message := notifier.Message{
+		Subject: fmt.Sprintf("Orçamento %s atingiu %d%% do limite", event.Budget.Description(), alert.Threshold()),
+		Body: fmt.Sprintf("O orçamento %s já usou %.1f%% do limite do período de %s a %s: %s gastos de %s.",
+			event.Budget.Description(),
+			usage,
+			alert.PeriodStart().Format("02/01/2006"),
+			event.PeriodEnd.Format("02/01/2006"),
+			alert.Spent(),
+			alert.Limit(),
+		),
+		Payload: dtos.BudgetThresholdCrossedPayload{
+			Event:       event.EventName(),
+			BudgetID:    event.Budget.ID(),
+			Description: event.Budget.Description(),
+			Threshold:   alert.Threshold(),
+			Usage:       usage,
+			Limit:       alert.Limit(),
+			Spent:       alert.Spent(),
+			PeriodStart: alert.PeriodStart(),
+			PeriodEnd:   event.PeriodEnd,
+		},
+	}
+
+	// a requisição que gerou a movimentação pode já ter terminado; a entrega não deve ser cancelada com ela
+	ctx := context.WithoutCancel(event.Context)
+	for _, target := range event.Notifiers {
+		channel, ok := h.notifiers[target.Channel()]
+		if !ok {
+			log.Printf("canal de notificação %s não configurado", target.Channel())
+			continue
+		}
+		if err := channel.Notify(ctx, target.Target(), message); err != nil {
+			log.Printf("erro ao notificar %s do alerta do orçamento %s: %v", target.Target(), event.Budget.ID(), err)
+		}
+	}
+}
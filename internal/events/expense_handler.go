@@ -1,6 +1,8 @@
 package events
 
 import (
+	"log"
+
 	"financial-backend/internal/models/events"
 	budgetmovement "financial-backend/internal/usecases/budget_movement"
 	"financial-backend/pkg/config"
@@ -28,3 +30,85 @@ func (h *ExpenseCreatedHandler) Handle(e config.Event) {
 	event := e.(*events.ExpenseCreatedEvent)
 	h.createExpense.CreateExpenseMovement(event.Context, event.Expense)
 }
+
+type ExpenseUpdatedHandler struct {
+	budgetMovement budgetmovement.UseCase
+}
+
+func NewExpenseUpdatedHandler(budgetMovement budgetmovement.UseCase) *ExpenseUpdatedHandler {
+	return &ExpenseUpdatedHandler{
+		budgetMovement: budgetMovement,
+	}
+}
+
+func (h *ExpenseUpdatedHandler) EventName() string {
+	return "ExpenseUpdated"
+}
+
+func (h *ExpenseUpdatedHandler) Handle(e config.Event) {
+	event := e.(*events.ExpenseUpdatedEvent)
+	if err := h.budgetMovement.SyncExpenseMovements(event.Context, event.Expense); err != nil {
+		log.Printf("erro ao sincronizar movimentações da despesa %s: %v", event.Expense.Id(), err)
+	}
+}
+
+type ExpenseDeletedHandler struct {
+	budgetMovement budgetmovement.UseCase
+}
+
+func NewExpenseDeletedHandler(budgetMovement budgetmovement.UseCase) *ExpenseDeletedHandler {
+	return &ExpenseDeletedHandler{
+		budgetMovement: budgetMovement,
+	}
+}
+
+func (h *ExpenseDeletedHandler) EventName() string {
+	return "ExpenseDeleted"
+}
+
+func (h *ExpenseDeletedHandler) Handle(e config.Event) {
+	event := e.(*events.ExpenseDeletedEvent)
+	if err := h.budgetMovement.ReverseExpenseMovements(event.Context, event.Expense.Id(), event.Strategy, event.KeepPastMonths); err != nil {
+		log.Printf("erro ao estornar movimentações da despesa %s: %v", event.Expense.Id(), err)
+	}
+}
+
+type ExpenseRestoredHandler struct {
+	budgetMovement budgetmovement.UseCase
+}
+
+func NewExpenseRestoredHandler(budgetMovement budgetmovement.UseCase) *ExpenseRestoredHandler {
+	return &ExpenseRestoredHandler{
+		budgetMovement: budgetMovement,
+	}
+}
+
+func (h *ExpenseRestoredHandler) EventName() string {
+	return "ExpenseRestored"
+}
+
+func (h *ExpenseRestoredHandler) Handle(e config.Event) {
+	event := e.(*events.ExpenseRestoredEvent)
+	if err := h.budgetMovement.RestoreExpenseMovements(event.Context, event.Expense); err != nil {
+		log.Printf("erro ao refazer movimentações da despesa %s: %v", event.Expense.Id(), err)
+	}
+}
+
+type ExpenseOverdueHandler struct{}
+
+func NewExpenseOverdueHandler() *ExpenseOverdueHandler {
+	return &ExpenseOverdueHandler{}
+}
+
+func (h *ExpenseOverdueHandler) EventName() string {
+	return "ExpenseOverdue"
+}
+
+func (h *ExpenseOverdueHandler) Handle(e config.Event) {
+	event := e.(*events.ExpenseOverdueEvent)
+	log.Printf("despesa %s (%s) vencida em %s sem pagamento",
+		event.Expense.Id(),
+		event.Expense.Description(),
+		event.Occurrence.Date.Format("2006-01-02"),
+	)
+}
@@ -0,0 +1,72 @@
+package events
+
+import (
+	"context"
+	"log"
+
+	"financial-backend/internal/models"
+	"financial-backend/internal/models/events"
+	budgetmovement "financial-backend/internal/usecases/budget_movement"
+	"financial-backend/internal/usecases/installment"
+	"financial-backend/pkg/config"
+)
+
+// ExpenseInstallmentsHandler mantém as parcelas gravadas em dia com a criação, alteração, exclusão e restauração de despesas.
+// Depois de gravar as parcelas, refaz as movimentações do orçamento das despesas parceladas a partir delas.
+type ExpenseInstallmentsHandler struct {
+	eventName      string
+	installments   installment.UseCase
+	budgetMovement budgetmovement.UseCase
+}
+
+func NewExpenseInstallmentsHandlers(installments installment.UseCase, budgetMovement budgetmovement.UseCase) []*ExpenseInstallmentsHandler {
+	return []*ExpenseInstallmentsHandler{
+		{eventName: "ExpenseCreated", installments: installments, budgetMovement: budgetMovement},
+		{eventName: "ExpenseUpdated", installments: installments, budgetMovement: budgetMovement},
+		{eventName: "ExpenseDeleted", installments: installments, budgetMovement: budgetMovement},
+		{eventName: "ExpenseRestored", installments: installments, budgetMovement: budgetMovement},
+	}
+}
+
+func (h *ExpenseInstallmentsHandler) EventName() string {
+	return h.eventName
+}
+
+func (h *ExpenseInstallmentsHandler) Handle(e config.Event) {
+	var err error
+	var expenseId string
+	var synced models.Expense
+	var ctx context.Context
+
+	switch event := e.(type) {
+	case *events.ExpenseCreatedEvent:
+		expenseId = event.Expense.Id()
+		ctx = event.Context
+		err = h.installments.GenerateForExpense(event.Context, event.Expense)
+		synced = event.Expense
+	case *events.ExpenseUpdatedEvent:
+		expenseId = event.Expense.Id()
+		ctx = event.Context
+		err = h.installments.SyncForExpense(event.Context, event.Expense)
+		synced = event.Expense
+	case *events.ExpenseDeletedEvent:
+		expenseId = event.Expense.Id()
+		err = h.installments.CancelForExpense(event.Context, expenseId)
+	case *events.ExpenseRestoredEvent:
+		expenseId = event.Expense.Id()
+		ctx = event.Context
+		err = h.installments.RestoreForExpense(event.Context, event.Expense)
+		synced = event.Expense
+	}
+
+	if err != nil {
+		log.Printf("erro ao atualizar parcelas da despesa %s: %v", expenseId, err)
+		return
+	}
+
+	if synced != nil && synced.Installments() != nil {
+		if err := h.budgetMovement.SyncInstallmentMovements(ctx, synced); err != nil {
+			log.Printf("erro ao sincronizar movimentações das parcelas da despesa %s: %v", expenseId, err)
+		}
+	}
+}
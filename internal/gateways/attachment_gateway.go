@@ -0,0 +1,52 @@
+package gateways
+
+import (
+	"context"
+	"financial-backend/internal/mappers"
+	"financial-backend/internal/models"
+	"financial-backend/internal/repositories/attachment"
+)
+
+type AttachmentGateway interface {
+	Create(ctx context.Context, attachment models.Attachment) error
+	Get(ctx context.Context, ownerType models.AttachmentOwner, ownerId, id string) (models.Attachment, error)
+	ListByOwner(ctx context.Context, ownerType models.AttachmentOwner, ownerId string) ([]models.Attachment, error)
+	Delete(ctx context.Context, id string) error
+}
+
+type attachmentGateway struct {
+	repo attachment.Repository
+}
+
+func NewAttachmentGateway(repo attachment.Repository) AttachmentGateway {
+	return &attachmentGateway{repo: repo}
+}
+
+func (g *attachmentGateway) Create(ctx context.Context, attachment models.Attachment) error {
+	return g.repo.Create(ctx, mappers.ToAttachmentEntity(attachment))
+}
+
+func (g *attachmentGateway) Get(ctx context.Context, ownerType models.AttachmentOwner, ownerId, id string) (models.Attachment, error) {
+	entity, err := g.repo.Get(ctx, string(ownerType), ownerId, id)
+	if err != nil {
+		return nil, err
+	}
+	return mappers.ToAttachmentModel(entity), nil
+}
+
+func (g *attachmentGateway) ListByOwner(ctx context.Context, ownerType models.AttachmentOwner, ownerId string) ([]models.Attachment, error) {
+	entities, err := g.repo.ListByOwner(ctx, string(ownerType), ownerId)
+	if err != nil {
+		return nil, err
+	}
+
+	attachments := make([]models.Attachment, len(entities))
+	for i, entity := range entities {
+		attachments[i] = mappers.ToAttachmentModel(&entity)
+	}
+	return attachments, nil
+}
+
+func (g *attachmentGateway) Delete(ctx context.Context, id string) error {
+	return g.repo.Delete(ctx, id)
+}
@@ -0,0 +1,65 @@
+package gateways
+
+import (
+	"context"
+	"financial-backend/internal/mappers"
+	"financial-backend/internal/models"
+	budgetalert "financial-backend/internal/repositories/budget_alert"
+)
+
+type BudgetAlertGateway interface {
+	CreateNotifier(ctx context.Context, notifier models.BudgetNotifier) error
+	DeleteNotifier(ctx context.Context, budgetId, id string) error
+	ListNotifiers(ctx context.Context, budgetId string) ([]models.BudgetNotifier, error)
+	// CreateAlert registra o alerta e retorna false quando o limite já tinha disparado no período
+	CreateAlert(ctx context.Context, alert models.BudgetAlert) (bool, error)
+	ListAlerts(ctx context.Context, budgetId string) ([]models.BudgetAlert, error)
+}
+
+type budgetAlertGateway struct {
+	repo budgetalert.Repository
+}
+
+func NewBudgetAlertGateway(repo budgetalert.Repository) BudgetAlertGateway {
+	return &budgetAlertGateway{repo: repo}
+}
+
+func (g *budgetAlertGateway) CreateNotifier(ctx context.Context, notifier models.BudgetNotifier) error {
+	return g.repo.CreateNotifier(ctx, mappers.ToBudgetNotifierEntity(notifier))
+}
+
+func (g *budgetAlertGateway) DeleteNotifier(ctx context.Context, budgetId, id string) error {
+	return g.repo.DeleteNotifier(ctx, budgetId, id)
+}
+
+func (g *budgetAlertGateway) ListNotifiers(ctx context.Context, budgetId string) ([]models.BudgetNotifier, error) {
+	entities, err := g.repo.ListNotifiers(ctx, budgetId)
+	if err != nil {
+		return nil, err
+	}
+
+	notifiers := make([]models.BudgetNotifier, 0, len(entities))
+	for _, entity := range entities {
+		if notifier := mappers.ToBudgetNotifierModel(&entity); notifier != nil {
+			notifiers = append(notifiers, notifier)
+		}
+	}
+	return notifiers, nil
+}
+
+func (g *budgetAlertGateway) CreateAlert(ctx context.Context, alert models.BudgetAlert) (bool, error) {
+	return g.repo.CreateAlert(ctx, mappers.ToBudgetAlertEntity(alert))
+}
+
+func (g *budgetAlertGateway) ListAlerts(ctx context.Context, budgetId string) ([]models.BudgetAlert, error) {
+	entities, err := g.repo.ListAlerts(ctx, budgetId)
+	if err != nil {
+		return nil, err
+	}
+
+	alerts := make([]models.BudgetAlert, len(entities))
+	for i, entity := range entities {
+		alerts[i] = mappers.ToBudgetAlertModel(&entity)
+	}
+	return alerts, nil
+}
@@ -2,6 +2,7 @@ package gateways
 
 import (
 	"context"
+	"financial-backend/internal/entities"
 	"financial-backend/internal/mappers"
 	"financial-backend/internal/models"
 	"financial-backend/internal/repositories/budget"
@@ -12,8 +13,13 @@ type BudgetGateway interface {
 	Update(ctx context.Context, budget models.Budget) error
 	Delete(ctx context.Context, id string) error
 	Get(ctx context.Context, id string) (models.Budget, error)
-	List(ctx context.Context, status string, description string, page models.PageRequest) ([]models.Budget, int64, error)
+	List(ctx context.Context, status string, description string, includeDeleted bool, page models.PageRequest) ([]models.Budget, int64, error)
+	Restore(ctx context.Context, id string) error
 	GetBudgetsWithoutMovement(ctx context.Context) ([]models.Budget, error)
+	UpdateAmount(ctx context.Context, budget models.Budget, version models.BudgetAmountVersion, movement models.BudgetMovement) error
+	Descendants(ctx context.Context, id string) ([]string, error)
+	ListDescendants(ctx context.Context, ids []string, includeDeleted bool) ([]models.Budget, error)
+	CountChildren(ctx context.Context, id string) (int64, error)
 }
 
 type budgetGateway struct {
@@ -46,8 +52,43 @@ func (g *budgetGateway) Get(ctx context.Context, id string) (models.Budget, erro
 	return mappers.ToBudgetModel(entity), nil
 }
 
-func (g *budgetGateway) List(ctx context.Context, status string, description string, page models.PageRequest) ([]models.Budget, int64, error) {
-	entities, count, err := g.repo.List(ctx, status, description, page)
+// UpdateAmount grava o orçamento, a nova versão do valor e, quando houver, o lançamento da diferença no período
+func (g *budgetGateway) UpdateAmount(ctx context.Context, budget models.Budget, version models.BudgetAmountVersion, movement models.BudgetMovement) error {
+	var movementEntity *entities.BudgetMovement
+	if movement != nil {
+		entity := mappers.ToBudgetMovementEntity(movement)
+		movementEntity = &entity
+	}
+	return g.repo.UpdateAmount(ctx, mappers.ToBudgetEntity(budget), mappers.ToBudgetAmountVersionEntity(version), movementEntity)
+}
+
+func (g *budgetGateway) Descendants(ctx context.Context, id string) ([]string, error) {
+	return g.repo.Descendants(ctx, id)
+}
+
+func (g *budgetGateway) ListDescendants(ctx context.Context, ids []string, includeDeleted bool) ([]models.Budget, error) {
+	entities, err := g.repo.ListDescendants(ctx, ids, includeDeleted)
+	if err != nil {
+		return nil, err
+	}
+
+	budgets := make([]models.Budget, len(entities))
+	for i, entity := range entities {
+		budgets[i] = mappers.ToBudgetModel(&entity)
+	}
+	return budgets, nil
+}
+
+func (g *budgetGateway) CountChildren(ctx context.Context, id string) (int64, error) {
+	return g.repo.CountChildren(ctx, id)
+}
+
+func (g *budgetGateway) Restore(ctx context.Context, id string) error {
+	return g.repo.Restore(ctx, id)
+}
+
+func (g *budgetGateway) List(ctx context.Context, status string, description string, includeDeleted bool, page models.PageRequest) ([]models.Budget, int64, error) {
+	entities, count, err := g.repo.List(ctx, status, description, includeDeleted, page)
 	if err != nil {
 		return nil, 0, err
 	}
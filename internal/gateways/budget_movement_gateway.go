@@ -7,14 +7,26 @@ import (
 	"financial-backend/internal/models"
 	budgetmovementRepository "financial-backend/internal/repositories/budget_movement"
 	. "financial-backend/internal/views"
+	"financial-backend/pkg/money"
+	"time"
 )
 
 type BudgetMovementGateway interface {
 	Create(ctx context.Context, budgetMovement models.BudgetMovement) error
 	CreateAll(ctx context.Context, movements []models.BudgetMovement) error
-	List(ctx context.Context, budgetId, movementType, origin string, month, year int, page models.PageRequest) ([]models.BudgetMovement, int64, error)
+	CreateAllWithinBalance(ctx context.Context, budgetId string, at time.Time, amount money.Money, movements []models.BudgetMovement) (money.Money, bool, error)
+	List(ctx context.Context, budgetId, movementType, origin string, month, year int, tag string, page models.PageRequest) ([]models.BudgetMovement, int64, error)
 	GetByID(ctx context.Context, id string) (models.BudgetMovement, error)
+	ListByOrigin(ctx context.Context, origin string, movementType models.MovementType, fromMonth, fromYear int) ([]models.BudgetMovement, error)
+	DeleteByOrigin(ctx context.Context, origin string, movementType models.MovementType, fromMonth, fromYear int) error
+	DeleteByInstallment(ctx context.Context, installmentId string) error
+	MoveByInstallment(ctx context.Context, installmentId string, dueDate time.Time) error
+	InstallmentExpensesWithoutLink(ctx context.Context) ([]string, error)
+	ListInPeriod(ctx context.Context, budgetId string, at time.Time) ([]models.BudgetMovement, error)
+	ClosingBalance(ctx context.Context, budgetId string, at time.Time) (money.Money, bool, error)
+	PeriodUsage(ctx context.Context, budgetId string, at time.Time) (limit, spent money.Money, err error)
 	SummaryBudgetUsageByMonthYear(ctx context.Context, month, year int) (data []SummaryBudgetUtilization, err error)
+	RecurringUsageByMonthYear(ctx context.Context, month, year int) (map[string]money.Money, error)
 }
 
 type budgetMovementGateway struct {
@@ -42,9 +54,43 @@ func (b *budgetMovementGateway) GetByID(ctx context.Context, id string) (models.
 	return mappers.ToBudgetMovementModel(*entity), nil
 }
 
+// ListByOrigin implements BudgetMovementGateway.
+func (b *budgetMovementGateway) ListByOrigin(ctx context.Context, origin string, movementType models.MovementType, fromMonth, fromYear int) ([]models.BudgetMovement, error) {
+	entities, err := b.repository.ListByOrigin(ctx, origin, string(movementType), fromMonth, fromYear)
+	if err != nil {
+		return nil, err
+	}
+
+	movements := make([]models.BudgetMovement, len(entities))
+	for i, entity := range entities {
+		movements[i] = mappers.ToBudgetMovementModel(entity)
+	}
+	return movements, nil
+}
+
+// DeleteByOrigin implements BudgetMovementGateway.
+func (b *budgetMovementGateway) DeleteByOrigin(ctx context.Context, origin string, movementType models.MovementType, fromMonth, fromYear int) error {
+	return b.repository.DeleteByOrigin(ctx, origin, string(movementType), fromMonth, fromYear)
+}
+
+// DeleteByInstallment implements BudgetMovementGateway.
+func (b *budgetMovementGateway) DeleteByInstallment(ctx context.Context, installmentId string) error {
+	return b.repository.DeleteByInstallment(ctx, installmentId)
+}
+
+// MoveByInstallment implements BudgetMovementGateway.
+func (b *budgetMovementGateway) MoveByInstallment(ctx context.Context, installmentId string, dueDate time.Time) error {
+	return b.repository.MoveByInstallment(ctx, installmentId, dueDate)
+}
+
+// InstallmentExpensesWithoutLink implements BudgetMovementGateway.
+func (b *budgetMovementGateway) InstallmentExpensesWithoutLink(ctx context.Context) ([]string, error) {
+	return b.repository.InstallmentExpensesWithoutLink(ctx)
+}
+
 // List implements BudgetMovementGateway.
-func (b *budgetMovementGateway) List(ctx context.Context, budgetId, movementType, origin string, month, year int, page models.PageRequest) ([]models.BudgetMovement, int64, error) {
-	entities, count, err := b.repository.List(ctx, budgetId, movementType, origin, month, year, page)
+func (b *budgetMovementGateway) List(ctx context.Context, budgetId, movementType, origin string, month, year int, tag string, page models.PageRequest) ([]models.BudgetMovement, int64, error) {
+	entities, count, err := b.repository.List(ctx, budgetId, movementType, origin, month, year, tag, page)
 
 	if err != nil {
 		return nil, 0, err
@@ -58,6 +104,10 @@ func (b *budgetMovementGateway) List(ctx context.Context, budgetId, movementType
 }
 
 func (b *budgetMovementGateway) CreateAll(ctx context.Context, movements []models.BudgetMovement) error {
+	if len(movements) == 0 {
+		return nil
+	}
+
 	entities := make([]entities.BudgetMovement, len(movements))
 
 	for i, model := range movements {
@@ -67,6 +117,45 @@ func (b *budgetMovementGateway) CreateAll(ctx context.Context, movements []model
 	return b.repository.CreateAll(ctx, entities)
 }
 
+// CreateAllWithinBalance implements BudgetMovementGateway.
+func (b *budgetMovementGateway) CreateAllWithinBalance(ctx context.Context, budgetId string, at time.Time, amount money.Money, movements []models.BudgetMovement) (money.Money, bool, error) {
+	entities := make([]entities.BudgetMovement, len(movements))
+	for i, model := range movements {
+		entities[i] = mappers.ToBudgetMovementEntity(model)
+	}
+
+	return b.repository.CreateAllWithinBalance(ctx, budgetId, at, amount, entities)
+}
+
+// ListInPeriod implements BudgetMovementGateway.
+func (b *budgetMovementGateway) ListInPeriod(ctx context.Context, budgetId string, at time.Time) ([]models.BudgetMovement, error) {
+	entities, err := b.repository.ListInPeriod(ctx, budgetId, at)
+	if err != nil {
+		return nil, err
+	}
+
+	movements := make([]models.BudgetMovement, len(entities))
+	for i, entity := range entities {
+		movements[i] = mappers.ToBudgetMovementModel(entity)
+	}
+	return movements, nil
+}
+
+// ClosingBalance implements BudgetMovementGateway.
+func (b *budgetMovementGateway) ClosingBalance(ctx context.Context, budgetId string, at time.Time) (money.Money, bool, error) {
+	return b.repository.ClosingBalance(ctx, budgetId, at)
+}
+
+// PeriodUsage implements BudgetMovementGateway.
+func (b *budgetMovementGateway) PeriodUsage(ctx context.Context, budgetId string, at time.Time) (limit, spent money.Money, err error) {
+	return b.repository.PeriodUsage(ctx, budgetId, at)
+}
+
+// RecurringUsageByMonthYear implements BudgetMovementGateway.
+func (b *budgetMovementGateway) RecurringUsageByMonthYear(ctx context.Context, month, year int) (map[string]money.Money, error) {
+	return b.repository.RecurringUsageByMonthYear(ctx, month, year)
+}
+
 func (b *budgetMovementGateway) SummaryBudgetUsageByMonthYear(ctx context.Context, month, year int) (data []SummaryBudgetUtilization, err error) {
 	data, err = b.repository.SummaryBudgetUsageByMonthYear(ctx, month, year)
 	if err != nil {
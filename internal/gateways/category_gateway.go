@@ -0,0 +1,67 @@
+package gateways
+
+import (
+	"context"
+	"financial-backend/internal/mappers"
+	"financial-backend/internal/models"
+	"financial-backend/internal/repositories/category"
+)
+
+type CategoryGateway interface {
+	Create(ctx context.Context, category models.Category) error
+	Update(ctx context.Context, category models.Category) error
+	Delete(ctx context.Context, id string) error
+	Get(ctx context.Context, id string) (models.Category, error)
+	List(ctx context.Context) ([]models.Category, error)
+	Descendants(ctx context.Context, id string) ([]string, error)
+	CountChildren(ctx context.Context, id string) (int64, error)
+}
+
+type categoryGateway struct {
+	repo category.Repository
+}
+
+func NewCategoryGateway(repo category.Repository) CategoryGateway {
+	return &categoryGateway{repo: repo}
+}
+
+func (g *categoryGateway) Create(ctx context.Context, category models.Category) error {
+	return g.repo.Create(ctx, mappers.ToCategoryEntity(category))
+}
+
+func (g *categoryGateway) Update(ctx context.Context, category models.Category) error {
+	return g.repo.Update(ctx, mappers.ToCategoryEntity(category))
+}
+
+func (g *categoryGateway) Delete(ctx context.Context, id string) error {
+	return g.repo.Delete(ctx, id)
+}
+
+func (g *categoryGateway) Get(ctx context.Context, id string) (models.Category, error) {
+	entity, err := g.repo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return mappers.ToCategoryModel(entity), nil
+}
+
+func (g *categoryGateway) List(ctx context.Context) ([]models.Category, error) {
+	entities, err := g.repo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	categories := make([]models.Category, len(entities))
+	for i, entity := range entities {
+		categories[i] = mappers.ToCategoryModel(&entity)
+	}
+	return categories, nil
+}
+
+func (g *categoryGateway) Descendants(ctx context.Context, id string) ([]string, error) {
+	return g.repo.Descendants(ctx, id)
+}
+
+func (g *categoryGateway) CountChildren(ctx context.Context, id string) (int64, error) {
+	return g.repo.CountChildren(ctx, id)
+}
@@ -0,0 +1,87 @@
+package gateways
+
+import (
+	"context"
+	"financial-backend/internal/entities"
+	"financial-backend/internal/mappers"
+	"financial-backend/internal/models"
+	creditcard "financial-backend/internal/repositories/credit_card"
+)
+
+type CreditCardGateway interface {
+	Create(ctx context.Context, card models.CreditCard) error
+	Update(ctx context.Context, card models.CreditCard) error
+	Delete(ctx context.Context, id string) error
+	CountExpenses(ctx context.Context, id string) (int64, error)
+	Get(ctx context.Context, id string) (models.CreditCard, error)
+	List(ctx context.Context, name string, page models.PageRequest) ([]models.CreditCard, int64, error)
+	CreatePayment(ctx context.Context, payment models.CreditCardPayment, installments []models.ExpenseInstallment) error
+	ListPayments(ctx context.Context, cardId string, year, month int) ([]models.CreditCardPayment, error)
+}
+
+type creditCardGateway struct {
+	repo creditcard.Repository
+}
+
+func NewCreditCardGateway(repo creditcard.Repository) CreditCardGateway {
+	return &creditCardGateway{repo: repo}
+}
+
+func (g *creditCardGateway) Create(ctx context.Context, card models.CreditCard) error {
+	return g.repo.Create(ctx, mappers.ToCreditCardEntity(card))
+}
+
+func (g *creditCardGateway) Update(ctx context.Context, card models.CreditCard) error {
+	return g.repo.Update(ctx, mappers.ToCreditCardEntity(card))
+}
+
+func (g *creditCardGateway) Delete(ctx context.Context, id string) error {
+	return g.repo.Delete(ctx, id)
+}
+
+func (g *creditCardGateway) CountExpenses(ctx context.Context, id string) (int64, error) {
+	return g.repo.CountExpenses(ctx, id)
+}
+
+func (g *creditCardGateway) Get(ctx context.Context, id string) (models.CreditCard, error) {
+	entity, err := g.repo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return mappers.ToCreditCardModel(entity), nil
+}
+
+func (g *creditCardGateway) List(ctx context.Context, name string, page models.PageRequest) ([]models.CreditCard, int64, error) {
+	entities, count, err := g.repo.List(ctx, name, page)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	cards := make([]models.CreditCard, len(entities))
+	for i, entity := range entities {
+		cards[i] = mappers.ToCreditCardModel(&entity)
+	}
+	return cards, count, nil
+}
+
+// CreatePayment grava o pagamento da fatura junto com as parcelas pagas por ele
+func (g *creditCardGateway) CreatePayment(ctx context.Context, payment models.CreditCardPayment, installments []models.ExpenseInstallment) error {
+	paid := make([]entities.ExpenseInstallment, len(installments))
+	for i, installment := range installments {
+		paid[i] = *mappers.ToExpenseInstallmentEntity(installment)
+	}
+	return g.repo.CreatePayment(ctx, mappers.ToCreditCardPaymentEntity(payment), paid)
+}
+
+func (g *creditCardGateway) ListPayments(ctx context.Context, cardId string, year, month int) ([]models.CreditCardPayment, error) {
+	entities, err := g.repo.ListPayments(ctx, cardId, year, month)
+	if err != nil {
+		return nil, err
+	}
+
+	payments := make([]models.CreditCardPayment, len(entities))
+	for i, entity := range entities {
+		payments[i] = mappers.ToCreditCardPaymentModel(&entity)
+	}
+	return payments, nil
+}
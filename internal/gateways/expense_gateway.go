@@ -8,6 +8,7 @@ import (
 	"financial-backend/internal/mappers"
 	"financial-backend/internal/models"
 	"financial-backend/internal/repositories/expense"
+	"financial-backend/pkg/money"
 )
 
 type ExpenseGateway interface {
@@ -15,9 +16,17 @@ type ExpenseGateway interface {
 	Update(ctx context.Context, expense models.Expense) error
 	Delete(ctx context.Context, id string) error
 	Get(ctx context.Context, id string) (models.Expense, error)
-	List(ctx context.Context, description, expenseType, category, budgetId, recurrecy, method string, page models.PageRequest) ([]models.Expense, int64, error)
+	List(ctx context.Context, description, expenseType, categoryId, budgetId, recurrecy, method, tag string, includeDeleted bool, page models.PageRequest) ([]models.Expense, int64, error)
+	Restore(ctx context.Context, id string) error
 	GetExpensesWithoutMovementInMonth(ctx context.Context) ([]models.Expense, error)
-	SummaryByMonth(ctx context.Context, month, year int) (amount float64, err error)
+	ListByCardBetween(ctx context.Context, cardId string, from, to time.Time) ([]models.Expense, error)
+	SummaryByMonth(ctx context.Context, month, year int) (amount money.Money, err error)
+	ListActiveBetween(ctx context.Context, from, to time.Time) ([]models.Expense, error)
+	ListShared(ctx context.Context) ([]models.Expense, error)
+	CreatePayment(ctx context.Context, payment models.ExpensePayment) error
+	ListPayments(ctx context.Context, expenseId string) ([]models.ExpensePayment, error)
+	ListPaymentsBetween(ctx context.Context, from, to time.Time) ([]models.ExpensePayment, error)
+	MarkOverdueNotified(ctx context.Context, expenseId string, occurrenceDate time.Time) (bool, error)
 }
 
 type expenseGateway struct {
@@ -29,33 +38,11 @@ func NewExpenseGateway(repo expense.Repository) ExpenseGateway {
 }
 
 func (g *expenseGateway) Create(ctx context.Context, expense models.Expense) error {
-	entity := &entities.Expense{
-		ID:           expense.Id(),
-		Description:  expense.Description(),
-		Amount:       expense.Amount(),
-		Type:         string(expense.Type()),
-		BudgetID:     expense.BudgetId(),
-		Recurrency:   (*string)(expense.Recurrency()),
-		Method:       string(expense.Method()),
-		Installments: expense.Installments(),
-		StartDate:    expense.StartDate(),
-		DueDay:       expense.DueDay(),
-		EndDate:      expense.EndDate(),
-		CreatedAt:    time.Now(),
-		UpdatedAt:    time.Now(),
-	}
-	return g.repo.Create(ctx, entity)
+	return g.repo.Create(ctx, mappers.ToExpenseEntity(expense))
 }
 
 func (g *expenseGateway) Update(ctx context.Context, expense models.Expense) error {
-	entity := &entities.Expense{
-		ID:          expense.Id(),
-		Description: expense.Description(),
-		Amount:      expense.Amount(),
-		Type:        string(expense.Type()),
-		UpdatedAt:   time.Now(),
-	}
-	return g.repo.Update(ctx, entity)
+	return g.repo.Update(ctx, mappers.ToExpenseEntity(expense))
 }
 
 func (g *expenseGateway) Delete(ctx context.Context, id string) error {
@@ -70,8 +57,12 @@ func (g *expenseGateway) Get(ctx context.Context, id string) (models.Expense, er
 	return mappers.ToExpenseModel(entity), nil
 }
 
-func (g *expenseGateway) List(ctx context.Context, description, expenseType, category, budgetId, recurrecy, method string, page models.PageRequest) ([]models.Expense, int64, error) {
-	entities, count, err := g.repo.List(ctx, description, expenseType, category, budgetId, recurrecy, method, page)
+func (g *expenseGateway) Restore(ctx context.Context, id string) error {
+	return g.repo.Restore(ctx, id)
+}
+
+func (g *expenseGateway) List(ctx context.Context, description, expenseType, categoryId, budgetId, recurrecy, method, tag string, includeDeleted bool, page models.PageRequest) ([]models.Expense, int64, error) {
+	entities, count, err := g.repo.List(ctx, description, expenseType, categoryId, budgetId, recurrecy, method, tag, includeDeleted, page)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -97,10 +88,96 @@ func (g *expenseGateway) GetExpensesWithoutMovementInMonth(ctx context.Context)
 
 	return responses, nil
 }
-func (g *expenseGateway) SummaryByMonth(ctx context.Context, month, year int) (amount float64, err error) {
-	amount, err = g.repo.SummaryByMonth(ctx, month, year)
+func (g *expenseGateway) SummaryByMonth(ctx context.Context, month, year int) (amount money.Money, err error) {
+	from, to := models.MonthRange(year, time.Month(month))
+	entities, err := g.repo.ListActiveBetween(ctx, from, to)
 	if err != nil {
 		return 0, err
 	}
+
+	for _, entity := range entities {
+		expense := mappers.ToExpenseModel(entity)
+		if expense == nil {
+			continue
+		}
+		for _, occurrence := range models.NewExpenseSchedule(expense).Occurrences(from, to) {
+			amount = amount.Add(occurrence.Amount)
+		}
+	}
 	return amount, nil
 }
+
+func (g *expenseGateway) ListByCardBetween(ctx context.Context, cardId string, from, to time.Time) ([]models.Expense, error) {
+	entities, err := g.repo.ListByCardBetween(ctx, cardId, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	expenses := make([]models.Expense, len(entities))
+	for i, entity := range entities {
+		expenses[i] = mappers.ToExpenseModel(entity)
+	}
+	return expenses, nil
+}
+
+func (g *expenseGateway) ListActiveBetween(ctx context.Context, from, to time.Time) ([]models.Expense, error) {
+	entities, err := g.repo.ListActiveBetween(ctx, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	expenses := make([]models.Expense, 0, len(entities))
+	for _, entity := range entities {
+		if expense := mappers.ToExpenseModel(entity); expense != nil {
+			expenses = append(expenses, expense)
+		}
+	}
+	return expenses, nil
+}
+
+func (g *expenseGateway) ListShared(ctx context.Context) ([]models.Expense, error) {
+	entities, err := g.repo.ListShared(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	expenses := make([]models.Expense, 0, len(entities))
+	for _, entity := range entities {
+		if expense := mappers.ToExpenseModel(entity); expense != nil {
+			expenses = append(expenses, expense)
+		}
+	}
+	return expenses, nil
+}
+
+func (g *expenseGateway) CreatePayment(ctx context.Context, payment models.ExpensePayment) error {
+	return g.repo.CreatePayment(ctx, mappers.ToExpensePaymentEntity(payment))
+}
+
+func (g *expenseGateway) ListPayments(ctx context.Context, expenseId string) ([]models.ExpensePayment, error) {
+	entities, err := g.repo.ListPayments(ctx, expenseId)
+	if err != nil {
+		return nil, err
+	}
+	return toExpensePaymentModels(entities), nil
+}
+
+func (g *expenseGateway) ListPaymentsBetween(ctx context.Context, from, to time.Time) ([]models.ExpensePayment, error) {
+	entities, err := g.repo.ListPaymentsBetween(ctx, from, to)
+	if err != nil {
+		return nil, err
+	}
+	return toExpensePaymentModels(entities), nil
+}
+
+func (g *expenseGateway) MarkOverdueNotified(ctx context.Context, expenseId string, occurrenceDate time.Time) (bool, error) {
+	return g.repo.MarkOverdueNotified(ctx, expenseId, occurrenceDate)
+}
+
+func toExpensePaymentModels(entities []entities.ExpensePayment) []models.ExpensePayment {
+	payments := make([]models.ExpensePayment, len(entities))
+	for i, entity := range entities {
+		payments[i] = mappers.ToExpensePaymentModel(&entity)
+	}
+	return payments
+}
@@ -2,10 +2,13 @@ package gateways
 
 import (
 	. "context"
+	"time"
 
 	"financial-backend/internal/entities"
+	"financial-backend/internal/mappers"
 	. "financial-backend/internal/models"
 	. "financial-backend/internal/repositories/income"
+	"financial-backend/pkg/money"
 )
 
 type IncomeGateway interface {
@@ -13,8 +16,10 @@ type IncomeGateway interface {
 	Update(ctx Context, income Income) error
 	Delete(ctx Context, id string) error
 	Get(ctx Context, id string) (Income, error)
-	List(ctx Context, incomeType, description string, page PageRequest) ([]Income, int64, error)
-	SummaryByMonth(ctx Context, month, year int) (amount float64, err error)
+	List(ctx Context, incomeType, description, categoryId, tag string, includeDeleted bool, page PageRequest) ([]Income, int64, error)
+	Restore(ctx Context, id string) error
+	SummaryByMonth(ctx Context, month, year int) (amount money.Money, err error)
+	ListActiveBetween(ctx Context, from, to time.Time) ([]Income, error)
 }
 type incomeGateway struct {
 	repo Repository
@@ -31,6 +36,9 @@ func (g *incomeGateway) Create(ctx Context, income Income) error {
 		Amount:      income.Amount(),
 		Type:        string(income.Type()),
 		DueDay:      income.DueDay(),
+		Rrule:       income.Rrule(),
+		CategoryID:  income.CategoryId(),
+		Tags:        mappers.ToTagEntities(income.Tags()),
 		StartDate:   income.StartDate(),
 		EndDate:     income.EndDate(),
 		CreatedAt:   income.CreatedAt(),
@@ -46,6 +54,9 @@ func (g *incomeGateway) Update(ctx Context, income Income) error {
 		Amount:      income.Amount(),
 		Type:        string(income.Type()),
 		DueDay:      income.DueDay(),
+		Rrule:       income.Rrule(),
+		CategoryID:  income.CategoryId(),
+		Tags:        mappers.ToTagEntities(income.Tags()),
 		StartDate:   income.StartDate(),
 		EndDate:     income.EndDate(),
 		CreatedAt:   income.CreatedAt(),
@@ -58,6 +69,10 @@ func (g *incomeGateway) Delete(ctx Context, id string) error {
 	return g.repo.Delete(ctx, id)
 }
 
+func (g *incomeGateway) Restore(ctx Context, id string) error {
+	return g.repo.Restore(ctx, id)
+}
+
 func (g *incomeGateway) Get(ctx Context, id string) (Income, error) {
 	entity, err := g.repo.Get(ctx, id)
 	if err != nil {
@@ -66,8 +81,8 @@ func (g *incomeGateway) Get(ctx Context, id string) (Income, error) {
 	return g.toModel(entity), nil
 }
 
-func (g *incomeGateway) List(ctx Context, incomeType, description string, page PageRequest) ([]Income, int64, error) {
-	entities, count, err := g.repo.List(ctx, description, incomeType, int(page.Limit), page.Offset())
+func (g *incomeGateway) List(ctx Context, incomeType, description, categoryId, tag string, includeDeleted bool, page PageRequest) ([]Income, int64, error) {
+	entities, count, err := g.repo.List(ctx, description, incomeType, categoryId, tag, includeDeleted, int(page.Limit), page.Offset())
 	if err != nil {
 		return nil, 0, err
 	}
@@ -86,12 +101,44 @@ func (g *incomeGateway) toModel(entity *entities.Income) Income {
 		entity.Amount,
 		IncomeType(entity.Type),
 		entity.DueDay,
+		entity.Rrule,
+		entity.CategoryID,
 		entity.StartDate,
 		entity.EndDate,
+		mappers.ToTagNames(entity.Tags),
 	)
+	if income != nil {
+		income = income.WithDeletedAt(mappers.ToDeletedAt(entity.DeletedAt))
+	}
 	return income
 }
 
-func (g *incomeGateway) SummaryByMonth(ctx Context, month, year int) (amount float64, err error) {
-	return g.repo.SummaryByMonth(ctx, month, year)
+func (g *incomeGateway) SummaryByMonth(ctx Context, month, year int) (amount money.Money, err error) {
+	from, to := MonthRange(year, time.Month(month))
+	entities, err := g.repo.ListActiveBetween(ctx, from, to)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, entity := range entities {
+		for _, occurrence := range NewIncomeSchedule(g.toModel(entity)).Occurrences(from, to) {
+			amount = amount.Add(occurrence.Amount)
+		}
+	}
+	return amount, nil
+}
+
+func (g *incomeGateway) ListActiveBetween(ctx Context, from, to time.Time) ([]Income, error) {
+	entities, err := g.repo.ListActiveBetween(ctx, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	incomes := make([]Income, 0, len(entities))
+	for _, entity := range entities {
+		if income := g.toModel(entity); income != nil {
+			incomes = append(incomes, income)
+		}
+	}
+	return incomes, nil
 }
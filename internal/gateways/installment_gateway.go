@@ -0,0 +1,102 @@
+package gateways
+
+import (
+	"context"
+	"financial-backend/internal/entities"
+	"financial-backend/internal/mappers"
+	"financial-backend/internal/models"
+	"financial-backend/internal/repositories/installment"
+	"financial-backend/internal/views"
+	"time"
+)
+
+type InstallmentGateway interface {
+	CreateAll(ctx context.Context, installments []models.ExpenseInstallment) error
+	Update(ctx context.Context, installment models.ExpenseInstallment) error
+	Get(ctx context.Context, id string) (models.ExpenseInstallment, error)
+	ListByExpense(ctx context.Context, expenseId string) ([]models.ExpenseInstallment, error)
+	ListByCardDueBetween(ctx context.Context, cardId string, from, to time.Time) ([]models.ExpenseInstallment, error)
+	DeleteOpenByExpense(ctx context.Context, expenseId string) error
+	CancelOpenByExpense(ctx context.Context, expenseId string) error
+	ReopenCancelledWithExpense(ctx context.Context, expenseId string) error
+	SummaryCommitted(ctx context.Context) ([]views.CommittedInstallments, error)
+	ExpensesWithoutInstallments(ctx context.Context) ([]string, error)
+}
+
+type installmentGateway struct {
+	repo installment.Repository
+}
+
+func NewInstallmentGateway(repo installment.Repository) InstallmentGateway {
+	return &installmentGateway{repo: repo}
+}
+
+func (g *installmentGateway) CreateAll(ctx context.Context, installments []models.ExpenseInstallment) error {
+	if len(installments) == 0 {
+		return nil
+	}
+
+	entities := make([]entities.ExpenseInstallment, len(installments))
+	for i, installment := range installments {
+		entities[i] = *mappers.ToExpenseInstallmentEntity(installment)
+	}
+	return g.repo.CreateAll(ctx, entities)
+}
+
+func (g *installmentGateway) Update(ctx context.Context, installment models.ExpenseInstallment) error {
+	return g.repo.Update(ctx, mappers.ToExpenseInstallmentEntity(installment))
+}
+
+func (g *installmentGateway) Get(ctx context.Context, id string) (models.ExpenseInstallment, error) {
+	entity, err := g.repo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return mappers.ToExpenseInstallmentModel(entity), nil
+}
+
+func (g *installmentGateway) ListByExpense(ctx context.Context, expenseId string) ([]models.ExpenseInstallment, error) {
+	entities, err := g.repo.ListByExpense(ctx, expenseId)
+	if err != nil {
+		return nil, err
+	}
+
+	installments := make([]models.ExpenseInstallment, len(entities))
+	for i, entity := range entities {
+		installments[i] = mappers.ToExpenseInstallmentModel(&entity)
+	}
+	return installments, nil
+}
+
+func (g *installmentGateway) ListByCardDueBetween(ctx context.Context, cardId string, from, to time.Time) ([]models.ExpenseInstallment, error) {
+	entities, err := g.repo.ListByCardDueBetween(ctx, cardId, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	installments := make([]models.ExpenseInstallment, len(entities))
+	for i, entity := range entities {
+		installments[i] = mappers.ToExpenseInstallmentModel(&entity)
+	}
+	return installments, nil
+}
+
+func (g *installmentGateway) DeleteOpenByExpense(ctx context.Context, expenseId string) error {
+	return g.repo.DeleteOpenByExpense(ctx, expenseId)
+}
+
+func (g *installmentGateway) CancelOpenByExpense(ctx context.Context, expenseId string) error {
+	return g.repo.CancelOpenByExpense(ctx, expenseId)
+}
+
+func (g *installmentGateway) ReopenCancelledWithExpense(ctx context.Context, expenseId string) error {
+	return g.repo.ReopenCancelledWithExpense(ctx, expenseId)
+}
+
+func (g *installmentGateway) SummaryCommitted(ctx context.Context) ([]views.CommittedInstallments, error) {
+	return g.repo.SummaryCommitted(ctx)
+}
+
+func (g *installmentGateway) ExpensesWithoutInstallments(ctx context.Context) ([]string, error) {
+	return g.repo.ExpensesWithoutInstallments(ctx)
+}
@@ -0,0 +1,62 @@
+package gateways
+
+import (
+	"context"
+	"financial-backend/internal/mappers"
+	"financial-backend/internal/models"
+	"financial-backend/internal/repositories/member"
+)
+
+type MemberGateway interface {
+	Create(ctx context.Context, member models.Member) error
+	Update(ctx context.Context, member models.Member) error
+	Delete(ctx context.Context, id string) error
+	Get(ctx context.Context, id string) (models.Member, error)
+	List(ctx context.Context) ([]models.Member, error)
+	CountUsages(ctx context.Context, id string) (int64, error)
+}
+
+type memberGateway struct {
+	repo member.Repository
+}
+
+func NewMemberGateway(repo member.Repository) MemberGateway {
+	return &memberGateway{repo: repo}
+}
+
+func (g *memberGateway) Create(ctx context.Context, member models.Member) error {
+	return g.repo.Create(ctx, mappers.ToMemberEntity(member))
+}
+
+func (g *memberGateway) Update(ctx context.Context, member models.Member) error {
+	return g.repo.Update(ctx, mappers.ToMemberEntity(member))
+}
+
+func (g *memberGateway) Delete(ctx context.Context, id string) error {
+	return g.repo.Delete(ctx, id)
+}
+
+func (g *memberGateway) Get(ctx context.Context, id string) (models.Member, error) {
+	entity, err := g.repo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return mappers.ToMemberModel(entity), nil
+}
+
+func (g *memberGateway) List(ctx context.Context) ([]models.Member, error) {
+	entities, err := g.repo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	members := make([]models.Member, len(entities))
+	for i, entity := range entities {
+		members[i] = mappers.ToMemberModel(&entity)
+	}
+	return members, nil
+}
+
+func (g *memberGateway) CountUsages(ctx context.Context, id string) (int64, error) {
+	return g.repo.CountUsages(ctx, id)
+}
@@ -0,0 +1,43 @@
+package gateways
+
+import (
+	"context"
+	"financial-backend/internal/entities"
+	"financial-backend/internal/mappers"
+	"financial-backend/internal/models"
+	"financial-backend/internal/repositories/settlement"
+)
+
+type SettlementGateway interface {
+	CreateAll(ctx context.Context, settlements []models.Settlement) error
+	List(ctx context.Context) ([]models.Settlement, error)
+}
+
+type settlementGateway struct {
+	repo settlement.Repository
+}
+
+func NewSettlementGateway(repo settlement.Repository) SettlementGateway {
+	return &settlementGateway{repo: repo}
+}
+
+func (g *settlementGateway) CreateAll(ctx context.Context, settlements []models.Settlement) error {
+	entities := make([]entities.Settlement, len(settlements))
+	for i, settlement := range settlements {
+		entities[i] = mappers.ToSettlementEntity(settlement)
+	}
+	return g.repo.CreateAll(ctx, entities)
+}
+
+func (g *settlementGateway) List(ctx context.Context) ([]models.Settlement, error) {
+	entities, err := g.repo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	settlements := make([]models.Settlement, len(entities))
+	for i, entity := range entities {
+		settlements[i] = mappers.ToSettlementModel(&entity)
+	}
+	return settlements, nil
+}
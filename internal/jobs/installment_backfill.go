@@ -0,0 +1,42 @@
+package jobs
+
+import (
+	"context"
+	"log"
+
+	budgetmovement "financial-backend/internal/usecases/budget_movement"
+	"financial-backend/internal/usecases/installment"
+)
+
+// InstallmentBackfill preenche os dados das despesas parceladas criadas antes das parcelas gravadas: primeiro as
+// parcelas e depois as movimentações do orçamento ligadas a elas. Só age sobre o que falta, então roda a cada inicialização.
+type InstallmentBackfill struct {
+	installments   installment.UseCase
+	budgetMovement budgetmovement.UseCase
+}
+
+func NewInstallmentBackfill(installments installment.UseCase, budgetMovement budgetmovement.UseCase) *InstallmentBackfill {
+	return &InstallmentBackfill{
+		installments:   installments,
+		budgetMovement: budgetMovement,
+	}
+}
+
+// Run executa o preenchimento uma vez
+func (j *InstallmentBackfill) Run(ctx context.Context) {
+	expenses, err := j.installments.Backfill(ctx)
+	if err != nil {
+		log.Printf("erro ao gravar parcelas das despesas antigas: %v", err)
+		return
+	}
+
+	movements, err := j.budgetMovement.BackfillInstallmentMovements(ctx)
+	if err != nil {
+		log.Printf("erro ao ligar movimentações às parcelas: %v", err)
+		return
+	}
+
+	if expenses > 0 || movements > 0 {
+		log.Printf("preenchimento de parcelas: %d despesa(s) com parcelas gravadas, %d com movimentações refeitas", expenses, movements)
+	}
+}
@@ -0,0 +1,49 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"financial-backend/internal/usecases/expense"
+)
+
+// OverdueCheck verifica periodicamente as despesas vencidas e sem pagamento.
+// A primeira execução considera os últimos lookback dias; as seguintes, apenas o que venceu desde o início da anterior.
+type OverdueCheck struct {
+	useCase  expense.UseCase
+	interval time.Duration
+	lookback time.Duration
+}
+
+func NewOverdueCheck(useCase expense.UseCase, interval time.Duration) *OverdueCheck {
+	return &OverdueCheck{
+		useCase:  useCase,
+		interval: interval,
+		lookback: 30 * 24 * time.Hour,
+	}
+}
+
+// Start executa a verificação imediatamente e depois a cada intervalo, até o contexto ser cancelado
+func (j *OverdueCheck) Start(ctx context.Context) {
+	since := time.Now().Add(-j.lookback)
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		now := time.Now()
+		count, err := j.useCase.CheckOverdue(ctx, since)
+		if err != nil {
+			log.Printf("erro ao verificar despesas vencidas: %v", err)
+		} else {
+			log.Printf("verificação de despesas vencidas: %d nova(s) ocorrência(s) em atraso", count)
+			since = now
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
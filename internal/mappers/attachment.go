@@ -0,0 +1,48 @@
+package mappers
+
+import (
+	"financial-backend/internal/dtos"
+	"financial-backend/internal/entities"
+	"financial-backend/internal/models"
+)
+
+func ToAttachmentModel(entity *entities.Attachment) models.Attachment {
+	attachment, _ := models.NewAttachment(
+		entity.ID,
+		models.AttachmentOwner(entity.OwnerType),
+		entity.OwnerID,
+		entity.FileName,
+		entity.ContentType,
+		entity.Size,
+		entity.Checksum,
+		entity.CreatedAt,
+	)
+	return attachment
+}
+
+func ToAttachmentEntity(attachment models.Attachment) *entities.Attachment {
+	return &entities.Attachment{
+		ID:          attachment.ID(),
+		OwnerType:   string(attachment.OwnerType()),
+		OwnerID:     attachment.OwnerId(),
+		FileName:    attachment.FileName(),
+		ContentType: attachment.ContentType(),
+		Size:        attachment.Size(),
+		Checksum:    attachment.Checksum(),
+		StorageKey:  attachment.StorageKey(),
+		CreatedAt:   attachment.CreatedAt(),
+	}
+}
+
+func ToAttachmentResponse(attachment models.Attachment) dtos.AttachmentResponse {
+	return dtos.AttachmentResponse{
+		ID:          attachment.ID(),
+		OwnerType:   string(attachment.OwnerType()),
+		OwnerID:     attachment.OwnerId(),
+		FileName:    attachment.FileName(),
+		ContentType: attachment.ContentType(),
+		Size:        attachment.Size(),
+		Checksum:    attachment.Checksum(),
+		CreatedAt:   attachment.CreatedAt(),
+	}
+}
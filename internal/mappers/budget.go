@@ -4,17 +4,85 @@ import (
 	"financial-backend/internal/dtos"
 	"financial-backend/internal/entities"
 	"financial-backend/internal/models"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
 func ToBudgetModel(entity *entities.Budget) models.Budget {
-	return models.NewBudget(
+	budget := models.NewBudget(
 		entity.ID,
 		entity.Amount,
 		entity.Description,
 		entity.EndDate,
 	)
+	budget.SetDeletedAt(ToDeletedAt(entity.DeletedAt))
+	budget.SetParentId(entity.ParentID)
+	if period, err := models.NewBudgetPeriod(entity.Period); err == nil {
+		budget.SetPeriod(period)
+	}
+	if policy, err := models.NewRolloverPolicy(entity.RolloverPolicy); err == nil {
+		_ = budget.SetRollover(policy, entity.RolloverCap)
+	}
+	_ = budget.SetAlertThresholds(toAlertThresholds(entity.AlertThresholds))
+
+	versions := make([]models.BudgetAmountVersion, 0, len(entity.AmountVersions))
+	for _, version := range entity.AmountVersions {
+		if model := ToBudgetAmountVersionModel(&version); model != nil {
+			versions = append(versions, model)
+		}
+	}
+	budget.SetAmountVersions(versions)
+	return budget
+}
+
+func ToBudgetAmountVersionModel(entity *entities.BudgetAmountVersion) models.BudgetAmountVersion {
+	version, _ := models.NewBudgetAmountVersion(entity.ID, entity.BudgetID, entity.Amount, entity.EffectiveFrom, entity.Reason, entity.CreatedAt)
+	return version
+}
+
+func ToBudgetAmountVersionEntity(version models.BudgetAmountVersion) *entities.BudgetAmountVersion {
+	return &entities.BudgetAmountVersion{
+		ID:            version.ID(),
+		BudgetID:      version.BudgetId(),
+		Amount:        version.Amount(),
+		EffectiveFrom: version.EffectiveFrom(),
+		Reason:        version.Reason(),
+		CreatedAt:     version.CreatedAt(),
+	}
+}
+
+// ToBudgetAmountHistory lista o valor inicial do orçamento seguido de cada mudança, na ordem em que passam a valer
+func ToBudgetAmountHistory(budget models.Budget) []dtos.BudgetAmountHistoryResponse {
+	first, _ := models.MonthRange(budget.CreatedAt().Year(), budget.CreatedAt().Month())
+	history := []dtos.BudgetAmountHistoryResponse{{
+		Amount:        budget.Amount(),
+		EffectiveFrom: first,
+		CreatedAt:     budget.CreatedAt(),
+	}}
+
+	versions := append([]models.BudgetAmountVersion{}, budget.AmountVersions()...)
+	sort.SliceStable(versions, func(i, j int) bool {
+		if versions[i].EffectiveFrom().Equal(versions[j].EffectiveFrom()) {
+			return versions[i].CreatedAt().Before(versions[j].CreatedAt())
+		}
+		return versions[i].EffectiveFrom().Before(versions[j].EffectiveFrom())
+	})
+
+	for _, version := range versions {
+		history = append(history, dtos.BudgetAmountHistoryResponse{
+			ID:            version.ID(),
+			Amount:        version.Amount(),
+			EffectiveFrom: version.EffectiveFrom(),
+			Reason:        version.Reason(),
+			CreatedAt:     version.CreatedAt(),
+		})
+	}
+	return history
 }
 
 func ToBudgetEntity(budget models.Budget) *entities.Budget {
@@ -22,29 +90,118 @@ func ToBudgetEntity(budget models.Budget) *entities.Budget {
 		ID:          budget.ID(),
 		Amount:      budget.Amount(),
 		Description: budget.Description(),
+		ParentID:    budget.ParentId(),
 		EndDate:     budget.EndDate(),
 		CreatedAt:   budget.CreatedAt(),
 		UpdatedAt:   budget.UpdatedAt(),
+
+		Period:         string(budget.Period()),
+		RolloverPolicy: string(budget.RolloverPolicy()),
+		RolloverCap:    budget.RolloverCap(),
+
+		AlertThresholds: fromAlertThresholds(budget.AlertThresholds()),
 	}
 }
 
-func FromDTOToBudgetModel(dto dtos.CreateBudgetRequest) models.Budget {
-	return models.NewBudget(
+// toAlertThresholds converte os percentuais de alerta gravados como "50,80,100"
+func toAlertThresholds(value string) []int {
+	var thresholds []int
+	for _, part := range strings.Split(value, ",") {
+		if threshold, err := strconv.Atoi(strings.TrimSpace(part)); err == nil {
+			thresholds = append(thresholds, threshold)
+		}
+	}
+	return thresholds
+}
+
+func fromAlertThresholds(thresholds []int) string {
+	parts := make([]string, len(thresholds))
+	for i, threshold := range thresholds {
+		parts[i] = strconv.Itoa(threshold)
+	}
+	return strings.Join(parts, ",")
+}
+
+func FromDTOToBudgetModel(dto dtos.CreateBudgetRequest) (models.Budget, error) {
+	budget := models.NewBudget(
 		uuid.New().String(),
 		dto.Amount,
 		dto.Description,
 		dto.EndDate,
 	)
+
+	period, err := models.NewBudgetPeriod(dto.Period)
+	if err != nil {
+		return nil, err
+	}
+	budget.SetPeriod(period)
+	budget.SetParentId(dto.ParentID)
+
+	policy, err := models.NewRolloverPolicy(dto.RolloverPolicy)
+	if err != nil {
+		return nil, err
+	}
+	if err := budget.SetRollover(policy, dto.RolloverCap); err != nil {
+		return nil, err
+	}
+	if err := budget.SetAlertThresholds(dto.AlertThresholds); err != nil {
+		return nil, err
+	}
+	return budget, nil
 }
 
 func ToBudgetResponse(budget models.Budget) dtos.BudgetResponse {
 	return dtos.BudgetResponse{
 		ID:          budget.ID(),
-		Amount:      budget.Amount(),
+		Amount:      budget.AmountAt(time.Now()),
 		Description: budget.Description(),
+		ParentID:    budget.ParentId(),
 		EndDate:     budget.EndDate(),
 		Status:      string(budget.Status()),
 		CreatedAt:   budget.CreatedAt(),
 		UpdatedAt:   budget.UpdatedAt(),
+		DeletedAt:   budget.DeletedAt(),
+
+		Period:         string(budget.Period()),
+		RolloverPolicy: string(budget.RolloverPolicy()),
+		RolloverCap:    budget.RolloverCap(),
+
+		AlertThresholds: budget.AlertThresholds(),
+	}
+}
+
+// ToDeletedAt converte a coluna de exclusão lógica; nil indica um registro ativo
+func ToDeletedAt(deletedAt gorm.DeletedAt) *time.Time {
+	if !deletedAt.Valid {
+		return nil
+	}
+	return &deletedAt.Time
+}
+
+// ToBudgetTree monta a árvore de orçamentos a partir de rootId; com rootId nil, retorna os orçamentos raiz
+func ToBudgetTree(budgets []models.Budget, rootId *string) []dtos.BudgetResponse {
+	children := map[string][]models.Budget{}
+	for _, budget := range budgets {
+		parent := ""
+		if budget.ParentId() != nil {
+			parent = *budget.ParentId()
+		}
+		children[parent] = append(children[parent], budget)
+	}
+
+	var build func(parent string) []dtos.BudgetResponse
+	build = func(parent string) []dtos.BudgetResponse {
+		responses := make([]dtos.BudgetResponse, len(children[parent]))
+		for i, budget := range children[parent] {
+			responses[i] = ToBudgetResponse(budget)
+			responses[i].Children = build(budget.ID())
+		}
+		return responses
+	}
+
+	root := ""
+	if rootId != nil {
+		root = *rootId
 	}
+	return build(root)
 }
@@ -0,0 +1,61 @@
+package mappers
+
+import (
+	"financial-backend/internal/dtos"
+	"financial-backend/internal/entities"
+	"financial-backend/internal/models"
+)
+
+func ToBudgetNotifierModel(entity *entities.BudgetNotifier) models.BudgetNotifier {
+	notifier, _ := models.NewBudgetNotifier(entity.ID, entity.BudgetID, models.NotifierChannel(entity.Channel), entity.Target, entity.CreatedAt)
+	return notifier
+}
+
+func ToBudgetNotifierEntity(notifier models.BudgetNotifier) *entities.BudgetNotifier {
+	return &entities.BudgetNotifier{
+		ID:        notifier.ID(),
+		BudgetID:  notifier.BudgetId(),
+		Channel:   string(notifier.Channel()),
+		Target:    notifier.Target(),
+		CreatedAt: notifier.CreatedAt(),
+	}
+}
+
+func ToBudgetNotifierResponse(notifier models.BudgetNotifier) dtos.BudgetNotifierResponse {
+	return dtos.BudgetNotifierResponse{
+		ID:        notifier.ID(),
+		BudgetID:  notifier.BudgetId(),
+		Channel:   string(notifier.Channel()),
+		Target:    notifier.Target(),
+		CreatedAt: notifier.CreatedAt(),
+	}
+}
+
+func ToBudgetAlertModel(entity *entities.BudgetAlert) models.BudgetAlert {
+	return models.NewBudgetAlert(entity.ID, entity.BudgetID, entity.Threshold, entity.PeriodStart, entity.Limit, entity.Spent).
+		WithCreatedAt(entity.CreatedAt)
+}
+
+func ToBudgetAlertEntity(alert models.BudgetAlert) *entities.BudgetAlert {
+	return &entities.BudgetAlert{
+		ID:          alert.ID(),
+		BudgetID:    alert.BudgetId(),
+		Threshold:   alert.Threshold(),
+		PeriodStart: alert.PeriodStart(),
+		Limit:       alert.Limit(),
+		Spent:       alert.Spent(),
+		CreatedAt:   alert.CreatedAt(),
+	}
+}
+
+func ToBudgetAlertResponse(alert models.BudgetAlert) dtos.BudgetAlertResponse {
+	return dtos.BudgetAlertResponse{
+		ID:          alert.ID(),
+		BudgetID:    alert.BudgetId(),
+		Threshold:   alert.Threshold(),
+		PeriodStart: alert.PeriodStart(),
+		Limit:       alert.Limit(),
+		Spent:       alert.Spent(),
+		CreatedAt:   alert.CreatedAt(),
+	}
+}
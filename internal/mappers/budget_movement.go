@@ -10,62 +10,91 @@ import (
 
 // ToEntity converts a BudgetMovement model to a BudgetMovement entity
 func ToBudgetMovementEntity(bm models.BudgetMovement) entities.BudgetMovement {
-	return entities.BudgetMovement{
+	date := bm.Date()
+	entity := entities.BudgetMovement{
 		ID:                bm.ID(),
 		BudgetId:          bm.BudgetId(),
 		Origin:            bm.Origin(),
 		Month:             bm.Month(),
 		Year:              bm.Year(),
+		Date:              &date,
 		Type:              string(bm.Type()),
 		Amount:            bm.Amount(),
+		Tags:              ToTagEntities(bm.Tags()),
 		CreatedAt:         bm.CreatedAt(),
+		InstallmentID:     bm.InstallmentId(),
 		OriginDescription: nil,
 	}
+	switch bm.Type() {
+	case models.MovementTransfer, models.MovementIncrease, models.MovementDecrease:
+		entity.CounterpartBudgetId = bm.CounterpartBudgetId()
+		entity.Reason = bm.OriginDescription()
+	}
+	return entity
 }
 
 // ToModel converts a BudgetMovement entity to a BudgetMovement model
 func ToBudgetMovementModel(bmEntity entities.BudgetMovement) models.BudgetMovement {
 	budget := ToBudgetModel(&bmEntity.Budget)
-	return models.NewBudgetMovement(
+	originDescription := bmEntity.OriginDescription
+	if originDescription == nil {
+		originDescription = bmEntity.Reason
+	}
+
+	movement := models.NewBudgetMovement(
 		bmEntity.ID,
 		bmEntity.BudgetId,
 		budget,
 		bmEntity.Origin,
-		bmEntity.OriginDescription,
+		originDescription,
 		bmEntity.Month,
 		bmEntity.Year,
 		models.MovementType(bmEntity.Type),
 		bmEntity.Amount,
-	)
+	).WithTags(ToTagNames(bmEntity.Tags)).WithCreatedAt(bmEntity.CreatedAt)
+
+	if bmEntity.Date != nil {
+		movement = movement.WithDate(*bmEntity.Date)
+	} else {
+		movement = movement.WithDate(bmEntity.CreatedAt)
+	}
+
+	if bmEntity.CounterpartBudgetId != nil {
+		var counterpart models.Budget
+		if bmEntity.CounterpartBudget != nil {
+			counterpart = ToBudgetModel(bmEntity.CounterpartBudget)
+		}
+		movement = movement.WithCounterpart(*bmEntity.CounterpartBudgetId, counterpart)
+	}
+	if bmEntity.InstallmentID != nil {
+		movement = movement.WithInstallment(*bmEntity.InstallmentID)
+	}
+	return movement
 }
 
 // ToDTO converts a BudgetMovement model to a BudgetMovementResponse DTO
 func ToBudgetMovementDTO(bm models.BudgetMovement) dtos.BudgetMovementResponse {
+	response := dtos.BudgetMovementResponse{
+		ID:                bm.ID(),
+		Origin:            bm.Origin(),
+		OriginDescription: bm.OriginDescription(),
+		Month:             bm.Month(),
+		Year:              bm.Year(),
+		Date:              bm.Date(),
+		Type:              string(bm.Type()),
+		Amount:            bm.Amount(),
+		Tags:              bm.Tags(),
+		CreatedAt:         bm.CreatedAt(),
+		InstallmentID:     bm.InstallmentId(),
+	}
 	if bm.Budget() != nil {
-		return dtos.BudgetMovementResponse{
-			ID:                bm.ID(),
-			Origin:            bm.Origin(),
-			OriginDescription: bm.OriginDescription(),
-			Budget:            ToBudgetResponse(bm.Budget()),
-			Month:             bm.Month(),
-			Year:              bm.Year(),
-			Type:              string(bm.Type()),
-			Amount:            bm.Amount(),
-			CreatedAt:         bm.CreatedAt(),
-		}
-	} else {
-		return dtos.BudgetMovementResponse{
-			ID:                bm.ID(),
-			Origin:            bm.Origin(),
-			OriginDescription: bm.OriginDescription(),
-			Month:             bm.Month(),
-			Year:              bm.Year(),
-			Type:              string(bm.Type()),
-			Amount:            bm.Amount(),
-			CreatedAt:         bm.CreatedAt(),
-		}
+		response.Budget = ToBudgetResponse(bm.Budget())
 	}
-
+	if bm.CounterpartBudget() != nil {
+		counterpart := ToBudgetResponse(bm.CounterpartBudget())
+		response.CounterpartBudget = &counterpart
+	}
+	return response
 }
 
 func FromDTOToBudgetMovementModel(bm dtos.BudgetMovementRequest) models.BudgetMovement {
@@ -81,3 +110,25 @@ func FromDTOToBudgetMovementModel(bm dtos.BudgetMovementRequest) models.BudgetMo
 		bm.Amount,
 	)
 }
+
+func ToBudgetBalanceResponse(statement models.BudgetStatement) dtos.BudgetBalanceResponse {
+	lines := make([]dtos.BudgetBalanceLine, len(statement.Lines))
+	for i, line := range statement.Lines {
+		lines[i] = dtos.BudgetBalanceLine{
+			BudgetMovementResponse: ToBudgetMovementDTO(line.Movement),
+			Balance:                line.Balance,
+		}
+	}
+
+	return dtos.BudgetBalanceResponse{
+		BudgetID:    statement.Budget.ID(),
+		Description: statement.Budget.Description(),
+		Period:      string(statement.Budget.Period()),
+		PeriodStart: statement.PeriodStart,
+		PeriodEnd:   statement.PeriodEnd,
+		Started:     statement.Started,
+		Opening:     statement.Opening,
+		Movements:   lines,
+		Closing:     statement.Closing,
+	}
+}
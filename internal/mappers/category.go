@@ -0,0 +1,60 @@
+package mappers
+
+import (
+	"financial-backend/internal/dtos"
+	"financial-backend/internal/entities"
+	"financial-backend/internal/models"
+)
+
+func ToCategoryModel(entity *entities.Category) models.Category {
+	category, _ := models.NewCategory(entity.ID, entity.Name, entity.ParentID)
+	return category
+}
+
+func ToCategoryEntity(category models.Category) *entities.Category {
+	return &entities.Category{
+		ID:        category.ID(),
+		Name:      category.Name(),
+		ParentID:  category.ParentId(),
+		CreatedAt: category.CreatedAt(),
+		UpdatedAt: category.UpdatedAt(),
+	}
+}
+
+func ToCategoryResponse(category models.Category) dtos.CategoryResponse {
+	return dtos.CategoryResponse{
+		ID:        category.ID(),
+		Name:      category.Name(),
+		ParentID:  category.ParentId(),
+		CreatedAt: category.CreatedAt(),
+		UpdatedAt: category.UpdatedAt(),
+	}
+}
+
+// ToCategoryTree monta a árvore de categorias a partir de rootId; com rootId nil, retorna as categorias raiz
+func ToCategoryTree(categories []models.Category, rootId *string) []dtos.CategoryResponse {
+	children := map[string][]models.Category{}
+	for _, category := range categories {
+		parent := ""
+		if category.ParentId() != nil {
+			parent = *category.ParentId()
+		}
+		children[parent] = append(children[parent], category)
+	}
+
+	var build func(parent string) []dtos.CategoryResponse
+	build = func(parent string) []dtos.CategoryResponse {
+		responses := make([]dtos.CategoryResponse, len(children[parent]))
+		for i, category := range children[parent] {
+			responses[i] = ToCategoryResponse(category)
+			responses[i].Children = build(category.ID())
+		}
+		return responses
+	}
+
+	root := ""
+	if rootId != nil {
+		root = *rootId
+	}
+	return build(root)
+}
@@ -0,0 +1,111 @@
+package mappers
+
+import (
+	"fmt"
+
+	"financial-backend/internal/dtos"
+	"financial-backend/internal/entities"
+	"financial-backend/internal/models"
+)
+
+func ToCreditCardModel(entity *entities.CreditCard) models.CreditCard {
+	card, _ := models.NewCreditCard(
+		entity.ID,
+		entity.Name,
+		entity.ClosingDay,
+		entity.DueDay,
+		entity.Limit,
+	)
+	return card
+}
+
+func ToCreditCardEntity(card models.CreditCard) *entities.CreditCard {
+	return &entities.CreditCard{
+		ID:         card.ID(),
+		Name:       card.Name(),
+		ClosingDay: card.ClosingDay(),
+		DueDay:     card.DueDay(),
+		Limit:      card.Limit(),
+		CreatedAt:  card.CreatedAt(),
+		UpdatedAt:  card.UpdatedAt(),
+	}
+}
+
+func ToCreditCardResponse(card models.CreditCard) dtos.CreditCardResponse {
+	return dtos.CreditCardResponse{
+		ID:         card.ID(),
+		Name:       card.Name(),
+		ClosingDay: card.ClosingDay(),
+		DueDay:     card.DueDay(),
+		Limit:      card.Limit(),
+		CreatedAt:  card.CreatedAt(),
+		UpdatedAt:  card.UpdatedAt(),
+	}
+}
+
+func ToCreditCardPaymentModel(entity *entities.CreditCardPayment) models.CreditCardPayment {
+	payment, _ := models.NewCreditCardPayment(
+		entity.ID,
+		entity.CardID,
+		entity.Year,
+		entity.Month,
+		entity.Amount,
+		entity.PaidAt,
+	)
+	return payment
+}
+
+func ToCreditCardPaymentEntity(payment models.CreditCardPayment) *entities.CreditCardPayment {
+	return &entities.CreditCardPayment{
+		ID:        payment.ID(),
+		CardID:    payment.CardId(),
+		Year:      payment.Year(),
+		Month:     payment.Month(),
+		Amount:    payment.Amount(),
+		PaidAt:    payment.PaidAt(),
+		CreatedAt: payment.CreatedAt(),
+	}
+}
+
+func ToCreditCardStatementResponse(statement models.CreditCardStatement) dtos.CreditCardStatementResponse {
+	paid := statement.Paid()
+
+	items := make([]dtos.CreditCardStatementItemResponse, len(statement.Items()))
+	for i, item := range statement.Items() {
+		items[i] = dtos.CreditCardStatementItemResponse{
+			ExpenseID:    item.Expense.Id(),
+			Description:  item.Expense.Description(),
+			Installment:  item.Occurrence.Number,
+			Installments: item.Expense.Installments(),
+			Amount:       item.Occurrence.Amount,
+			DueDate:      item.Occurrence.Date,
+			Paid:         statement.ItemPaid(item),
+		}
+		if item.Expense.Installments() != nil {
+			items[i].InstallmentLabel = fmt.Sprintf("%d/%d", item.Occurrence.Number, *item.Expense.Installments())
+		}
+	}
+
+	payments := make([]dtos.CreditCardPaymentResponse, len(statement.Payments()))
+	for i, payment := range statement.Payments() {
+		payments[i] = dtos.CreditCardPaymentResponse{
+			ID:     payment.ID(),
+			Amount: payment.Amount(),
+			PaidAt: payment.PaidAt(),
+		}
+	}
+
+	return dtos.CreditCardStatementResponse{
+		CardID:      statement.Card().ID(),
+		CardName:    statement.Card().Name(),
+		Year:        statement.Year(),
+		Month:       statement.Month(),
+		ClosingDate: statement.ClosingDate(),
+		DueDate:     statement.DueDate(),
+		Total:       statement.Total(),
+		PaidAmount:  statement.PaidAmount(),
+		Paid:        paid,
+		Items:       items,
+		Payments:    payments,
+	}
+}
@@ -1,8 +1,12 @@
 package mappers
 
 import (
+	"financial-backend/internal/dtos"
 	"financial-backend/internal/entities"
 	"financial-backend/internal/models"
+	"time"
+
+	"github.com/google/uuid"
 )
 
 func ToExpenseModel(entity *entities.Expense) models.Expense {
@@ -17,13 +21,169 @@ func ToExpenseModel(entity *entities.Expense) models.Expense {
 		entity.Amount,
 		entity.Type,
 		entity.BudgetID,
+		entity.CardID,
+		entity.CategoryID,
 		entity.Recurrency,
+		entity.Rrule,
 		entity.Method,
 		entity.Installments,
 		entity.DueDay,
 		entity.StartDate,
 		entity.EndDate,
+		ToTagNames(entity.Tags),
+		toExpenseAllocationModels(entity.Allocations),
 		budget,
 	)
+	if expense != nil && entity.PaidByID != nil && entity.SplitType != nil {
+		split, _ := models.NewExpenseSplit(entity.Amount, *entity.PaidByID, *entity.SplitType, toExpenseShareModels(entity.Shares))
+		expense = expense.WithSplit(split)
+	}
+	if expense != nil {
+		expense = expense.WithDeletedAt(ToDeletedAt(entity.DeletedAt))
+	}
 	return expense
 }
+
+func ToExpenseEntity(expense models.Expense) *entities.Expense {
+	entity := &entities.Expense{
+		ID:           expense.Id(),
+		Description:  expense.Description(),
+		Amount:       expense.Amount(),
+		Type:         string(expense.Type()),
+		BudgetID:     expense.BudgetId(),
+		CardID:       expense.CardId(),
+		CategoryID:   expense.CategoryId(),
+		Tags:         ToTagEntities(expense.Tags()),
+		Allocations:  toExpenseAllocationEntities(expense),
+		Recurrency:   (*string)(expense.Recurrency()),
+		Rrule:        expense.Rrule(),
+		Method:       string(expense.Method()),
+		Installments: expense.Installments(),
+		StartDate:    expense.StartDate(),
+		DueDay:       expense.DueDay(),
+		EndDate:      expense.EndDate(),
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	}
+
+	if split := expense.Split(); split != nil {
+		splitType := string(split.Type)
+		entity.PaidByID = &split.PaidBy
+		entity.SplitType = &splitType
+		entity.Shares = make([]entities.ExpenseShare, len(split.Shares))
+		for i, share := range split.Shares {
+			entity.Shares[i] = entities.ExpenseShare{
+				ID:         uuid.New().String(),
+				ExpenseID:  expense.Id(),
+				MemberID:   share.MemberId,
+				Amount:     share.Amount,
+				Percentage: share.Percentage,
+			}
+		}
+	}
+	return entity
+}
+
+func toExpenseAllocationModels(entities []entities.ExpenseAllocation) []models.ExpenseAllocation {
+	allocations := make([]models.ExpenseAllocation, len(entities))
+	for i, entity := range entities {
+		allocations[i] = models.ExpenseAllocation{
+			BudgetId:   entity.BudgetID,
+			Amount:     entity.Amount,
+			Percentage: entity.Percentage,
+		}
+	}
+	return allocations
+}
+
+func toExpenseAllocationEntities(expense models.Expense) []entities.ExpenseAllocation {
+	allocations := make([]entities.ExpenseAllocation, len(expense.Allocations()))
+	for i, allocation := range expense.Allocations() {
+		allocations[i] = entities.ExpenseAllocation{
+			ID:         uuid.New().String(),
+			ExpenseID:  expense.Id(),
+			BudgetID:   allocation.BudgetId,
+			Amount:     allocation.Amount,
+			Percentage: allocation.Percentage,
+		}
+	}
+	return allocations
+}
+
+func ToExpenseAllocationDTOs(allocations []models.ExpenseAllocation) []dtos.ExpenseAllocationDTO {
+	if len(allocations) == 0 {
+		return nil
+	}
+
+	responses := make([]dtos.ExpenseAllocationDTO, len(allocations))
+	for i, allocation := range allocations {
+		amount := allocation.Amount
+		responses[i] = dtos.ExpenseAllocationDTO{
+			BudgetID:   allocation.BudgetId,
+			Amount:     &amount,
+			Percentage: allocation.Percentage,
+		}
+	}
+	return responses
+}
+
+func ToExpenseAllocationModels(allocations []dtos.ExpenseAllocationDTO) []models.ExpenseAllocation {
+	if allocations == nil {
+		return nil
+	}
+
+	models := make([]models.ExpenseAllocation, len(allocations))
+	for i, allocation := range allocations {
+		models[i].BudgetId = allocation.BudgetID
+		models[i].Percentage = allocation.Percentage
+		if allocation.Amount != nil {
+			models[i].Amount = *allocation.Amount
+		}
+	}
+	return models
+}
+
+func toExpenseShareModels(entities []entities.ExpenseShare) []models.ExpenseShare {
+	shares := make([]models.ExpenseShare, len(entities))
+	for i, entity := range entities {
+		shares[i] = models.ExpenseShare{
+			MemberId:   entity.MemberID,
+			Amount:     entity.Amount,
+			Percentage: entity.Percentage,
+		}
+	}
+	return shares
+}
+
+func ToExpenseSplitDTO(split *models.ExpenseSplit) *dtos.ExpenseSplitDTO {
+	if split == nil {
+		return nil
+	}
+
+	shares := make([]dtos.ExpenseShareDTO, len(split.Shares))
+	for i, share := range split.Shares {
+		amount := share.Amount
+		shares[i] = dtos.ExpenseShareDTO{
+			MemberID:   share.MemberId,
+			Amount:     &amount,
+			Percentage: share.Percentage,
+		}
+	}
+	return &dtos.ExpenseSplitDTO{
+		PaidBy: split.PaidBy,
+		Type:   string(split.Type),
+		Shares: shares,
+	}
+}
+
+func ToExpenseShareModels(shares []dtos.ExpenseShareDTO) []models.ExpenseShare {
+	models := make([]models.ExpenseShare, len(shares))
+	for i, share := range shares {
+		models[i].MemberId = share.MemberID
+		models[i].Percentage = share.Percentage
+		if share.Amount != nil {
+			models[i].Amount = *share.Amount
+		}
+	}
+	return models
+}
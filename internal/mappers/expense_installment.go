@@ -0,0 +1,48 @@
+package mappers
+
+import (
+	"financial-backend/internal/dtos"
+	"financial-backend/internal/entities"
+	"financial-backend/internal/models"
+	"time"
+)
+
+func ToExpenseInstallmentModel(entity *entities.ExpenseInstallment) models.ExpenseInstallment {
+	return models.NewExpenseInstallment(
+		entity.ID,
+		entity.ExpenseID,
+		entity.Number,
+		entity.DueDate,
+		entity.Amount,
+		models.InstallmentStatus(entity.Status),
+		entity.PaidAt,
+	).WithRescheduled(entity.Rescheduled)
+}
+
+func ToExpenseInstallmentEntity(installment models.ExpenseInstallment) *entities.ExpenseInstallment {
+	return &entities.ExpenseInstallment{
+		ID:          installment.ID(),
+		ExpenseID:   installment.ExpenseId(),
+		Number:      installment.Number(),
+		DueDate:     installment.DueDate(),
+		Amount:      installment.Amount(),
+		Status:      string(installment.Status()),
+		PaidAt:      installment.PaidAt(),
+		Rescheduled: installment.Rescheduled(),
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+}
+
+func ToExpenseInstallmentResponse(installment models.ExpenseInstallment) dtos.ExpenseInstallmentResponse {
+	return dtos.ExpenseInstallmentResponse{
+		ID:          installment.ID(),
+		ExpenseID:   installment.ExpenseId(),
+		Number:      installment.Number(),
+		DueDate:     installment.DueDate(),
+		Amount:      installment.Amount(),
+		Status:      string(installment.Status()),
+		PaidAt:      installment.PaidAt(),
+		Rescheduled: installment.Rescheduled(),
+	}
+}
@@ -0,0 +1,55 @@
+package mappers
+
+import (
+	"financial-backend/internal/dtos"
+	"financial-backend/internal/entities"
+	"financial-backend/internal/models"
+	"time"
+)
+
+func ToExpensePaymentModel(entity *entities.ExpensePayment) models.ExpensePayment {
+	payment, _ := models.NewExpensePayment(
+		entity.ID,
+		entity.ExpenseID,
+		entity.OccurrenceDate,
+		entity.Amount,
+		entity.PaidAt,
+		entity.Note,
+	)
+	return payment
+}
+
+func ToExpensePaymentEntity(payment models.ExpensePayment) *entities.ExpensePayment {
+	return &entities.ExpensePayment{
+		ID:             payment.ID(),
+		ExpenseID:      payment.ExpenseId(),
+		OccurrenceDate: payment.OccurrenceDate(),
+		Amount:         payment.Amount(),
+		PaidAt:         payment.PaidAt(),
+		Note:           payment.Note(),
+		CreatedAt:      payment.CreatedAt(),
+	}
+}
+
+func ToExpensePaymentResponse(payment models.ExpensePayment) dtos.ExpensePaymentResponse {
+	return dtos.ExpensePaymentResponse{
+		ID:             payment.ID(),
+		ExpenseID:      payment.ExpenseId(),
+		OccurrenceDate: payment.OccurrenceDate(),
+		Amount:         payment.Amount(),
+		PaidAt:         payment.PaidAt(),
+		Note:           payment.Note(),
+	}
+}
+
+func ToOverdueExpenseResponse(overdue models.OverdueOccurrence, today time.Time) dtos.OverdueExpenseResponse {
+	return dtos.OverdueExpenseResponse{
+		ExpenseID:   overdue.Expense.Id(),
+		Description: overdue.Expense.Description(),
+		Method:      string(overdue.Expense.Method()),
+		Number:      overdue.Occurrence.Number,
+		DueDate:     overdue.Occurrence.Date,
+		Amount:      overdue.Occurrence.Amount,
+		DaysOverdue: int(today.Sub(overdue.Occurrence.Date).Hours() / 24),
+	}
+}
@@ -0,0 +1,30 @@
+package mappers
+
+import (
+	"financial-backend/internal/dtos"
+	"financial-backend/internal/entities"
+	"financial-backend/internal/models"
+)
+
+func ToMemberModel(entity *entities.Member) models.Member {
+	member, _ := models.NewMember(entity.ID, entity.Name)
+	return member
+}
+
+func ToMemberEntity(member models.Member) *entities.Member {
+	return &entities.Member{
+		ID:        member.ID(),
+		Name:      member.Name(),
+		CreatedAt: member.CreatedAt(),
+		UpdatedAt: member.UpdatedAt(),
+	}
+}
+
+func ToMemberResponse(member models.Member) dtos.MemberResponse {
+	return dtos.MemberResponse{
+		ID:        member.ID(),
+		Name:      member.Name(),
+		CreatedAt: member.CreatedAt(),
+		UpdatedAt: member.UpdatedAt(),
+	}
+}
@@ -0,0 +1,19 @@
+package mappers
+
+import (
+	"financial-backend/internal/dtos"
+	"financial-backend/internal/models"
+)
+
+func ToOccurrenceResponses(schedule models.Schedule, occurrences []models.Occurrence) []dtos.OccurrenceResponse {
+	responses := make([]dtos.OccurrenceResponse, len(occurrences))
+	for i, occurrence := range occurrences {
+		responses[i] = dtos.OccurrenceResponse{
+			Number: occurrence.Number,
+			Total:  schedule.Count(),
+			Date:   occurrence.Date,
+			Amount: occurrence.Amount,
+		}
+	}
+	return responses
+}
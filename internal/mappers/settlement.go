@@ -0,0 +1,57 @@
+package mappers
+
+import (
+	"financial-backend/internal/dtos"
+	"financial-backend/internal/entities"
+	"financial-backend/internal/models"
+	"time"
+)
+
+func ToSettlementModel(entity *entities.Settlement) models.Settlement {
+	settlement, _ := models.NewSettlement(entity.ID, entity.FromMemberID, entity.ToMemberID, entity.Amount, entity.SettledAt)
+	return settlement
+}
+
+func ToSettlementEntity(settlement models.Settlement) entities.Settlement {
+	return entities.Settlement{
+		ID:           settlement.ID(),
+		FromMemberID: settlement.FromMemberId(),
+		ToMemberID:   settlement.ToMemberId(),
+		Amount:       settlement.Amount(),
+		SettledAt:    settlement.SettledAt(),
+		CreatedAt:    time.Now(),
+	}
+}
+
+func ToSettlementResponse(settlement models.Settlement, names map[string]string) dtos.SettlementResponse {
+	return dtos.SettlementResponse{
+		ID:        settlement.ID(),
+		From:      dtos.MemberRef{ID: settlement.FromMemberId(), Name: names[settlement.FromMemberId()]},
+		To:        dtos.MemberRef{ID: settlement.ToMemberId(), Name: names[settlement.ToMemberId()]},
+		Amount:    settlement.Amount(),
+		SettledAt: settlement.SettledAt(),
+	}
+}
+
+func ToSettlementSummary(balances []models.MemberBalance, transfers []models.Transfer, names map[string]string) dtos.SettlementSummaryResponse {
+	summary := dtos.SettlementSummaryResponse{
+		Balances:  make([]dtos.MemberBalanceResponse, len(balances)),
+		Transfers: make([]dtos.TransferResponse, len(transfers)),
+	}
+	for i, balance := range balances {
+		summary.Balances[i] = dtos.MemberBalanceResponse{
+			Member:  dtos.MemberRef{ID: balance.MemberId, Name: names[balance.MemberId]},
+			Paid:    balance.Paid,
+			Share:   balance.Share,
+			Balance: balance.Balance,
+		}
+	}
+	for i, transfer := range transfers {
+		summary.Transfers[i] = dtos.TransferResponse{
+			From:   dtos.MemberRef{ID: transfer.FromMemberId, Name: names[transfer.FromMemberId]},
+			To:     dtos.MemberRef{ID: transfer.ToMemberId, Name: names[transfer.ToMemberId]},
+			Amount: transfer.Amount,
+		}
+	}
+	return summary
+}
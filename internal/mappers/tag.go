@@ -0,0 +1,19 @@
+package mappers
+
+import "financial-backend/internal/entities"
+
+func ToTagEntities(tags []string) []entities.Tag {
+	entities := make([]entities.Tag, len(tags))
+	for i, tag := range tags {
+		entities[i].Name = tag
+	}
+	return entities
+}
+
+func ToTagNames(tags []entities.Tag) []string {
+	names := make([]string, len(tags))
+	for i, tag := range tags {
+		names[i] = tag.Name
+	}
+	return names
+}
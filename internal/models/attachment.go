@@ -0,0 +1,122 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+type AttachmentOwner string
+
+const (
+	AttachmentOwnerExpense AttachmentOwner = "expense"
+	AttachmentOwnerIncome  AttachmentOwner = "income"
+)
+
+// MaxAttachmentSize é o tamanho máximo de um anexo (10 MB)
+const MaxAttachmentSize int64 = 10 << 20
+
+type Attachment interface {
+	ID() string
+	OwnerType() AttachmentOwner
+	OwnerId() string
+	FileName() string
+	ContentType() string
+	Size() int64
+	Checksum() string
+	StorageKey() string
+	CreatedAt() time.Time
+}
+
+type attachment struct {
+	id          string
+	ownerType   AttachmentOwner
+	ownerId     string
+	fileName    string
+	contentType string
+	size        int64
+	checksum    string
+	createdAt   time.Time
+}
+
+func NewAttachment(id string, ownerType AttachmentOwner, ownerId, fileName, contentType string, size int64, checksum string, createdAt time.Time) (Attachment, error) {
+	if ownerType != AttachmentOwnerExpense && ownerType != AttachmentOwnerIncome {
+		return nil, fmt.Errorf("tipo de dono do anexo inválido: %s", ownerType)
+	}
+
+	if !AllowedAttachmentType(contentType) {
+		return nil, fmt.Errorf("tipo de arquivo não permitido: %s; envie PDF ou imagem", contentType)
+	}
+
+	if size <= 0 {
+		return nil, errors.New("arquivo vazio")
+	}
+
+	if size > MaxAttachmentSize {
+		return nil, fmt.Errorf("arquivo maior que o limite de %d MB", MaxAttachmentSize>>20)
+	}
+
+	fileName = filepath.Base(strings.TrimSpace(fileName))
+	if fileName == "" || fileName == "." || fileName == string(filepath.Separator) {
+		fileName = id
+	}
+
+	return &attachment{
+		id:          id,
+		ownerType:   ownerType,
+		ownerId:     ownerId,
+		fileName:    fileName,
+		contentType: contentType,
+		size:        size,
+		checksum:    checksum,
+		createdAt:   createdAt,
+	}, nil
+}
+
+// AllowedAttachmentType indica se o tipo de conteúdo é um PDF ou uma imagem
+func AllowedAttachmentType(contentType string) bool {
+	return contentType == "application/pdf" || strings.HasPrefix(contentType, "image/")
+}
+
+// AttachmentStorageKey monta a chave do arquivo no armazenamento
+func AttachmentStorageKey(ownerType AttachmentOwner, ownerId, id string) string {
+	return fmt.Sprintf("%s/%s/%s", ownerType, ownerId, id)
+}
+
+func (a *attachment) ID() string {
+	return a.id
+}
+
+func (a *attachment) OwnerType() AttachmentOwner {
+	return a.ownerType
+}
+
+func (a *attachment) OwnerId() string {
+	return a.ownerId
+}
+
+func (a *attachment) FileName() string {
+	return a.fileName
+}
+
+func (a *attachment) ContentType() string {
+	return a.contentType
+}
+
+func (a *attachment) Size() int64 {
+	return a.size
+}
+
+func (a *attachment) Checksum() string {
+	return a.checksum
+}
+
+func (a *attachment) StorageKey() string {
+	return AttachmentStorageKey(a.ownerType, a.ownerId, a.id)
+}
+
+func (a *attachment) CreatedAt() time.Time {
+	return a.createdAt
+}
@@ -1,6 +1,8 @@
 package models
 
 import (
+	"financial-backend/pkg/money"
+	"fmt"
 	"strings"
 	"time"
 )
@@ -14,26 +16,57 @@ const (
 
 type Budget interface {
 	ID() string
-	Amount() float64
+	// Amount é o valor com que o orçamento foi criado; use AmountAt para o valor em vigor em um mês
+	Amount() money.Money
+	// AmountAt retorna o valor em vigor na data informada, considerando as versões de valor do orçamento
+	AmountAt(at time.Time) money.Money
+	AmountVersions() []BudgetAmountVersion
 	Description() string
+	// ParentId é o orçamento pai; nil indica um orçamento raiz
+	ParentId() *string
 	Status() BudgetStatus
 	EndDate() *time.Time
 	CreatedAt() time.Time
 	UpdatedAt() time.Time
+	DeletedAt() *time.Time
+	Period() BudgetPeriod
+	RolloverPolicy() RolloverPolicy
+	RolloverCap() *money.Money
+	// AlertThresholds são os percentuais de uso do período que disparam alertas; vazio usa o padrão da aplicação
+	AlertThresholds() []int
 
 	SetEndDate(endDate time.Time)
+	SetParentId(parentId *string)
+	SetPeriod(period BudgetPeriod)
+	// SetRollover define a política de rollover; a política capped exige um teto positivo
+	SetRollover(policy RolloverPolicy, cap *money.Money) error
+	// SetDeletedAt marca o orçamento como excluído; nil indica um orçamento ativo
+	SetDeletedAt(deletedAt *time.Time)
+	SetAlertThresholds(thresholds []int) error
+	SetAmountVersions(versions []BudgetAmountVersion)
+	// ChangeAmount registra um novo valor a partir do mês da versão; meses anteriores ao atual não podem ser alterados
+	ChangeAmount(version BudgetAmountVersion, now time.Time) error
 }
 
 type budget struct {
 	id          string
-	amount      float64
+	amount      money.Money
 	description string
+	parentId    *string
 	endDate     *time.Time
 	createdAt   time.Time
 	updatedAt   time.Time
+	deletedAt   *time.Time
+	period      BudgetPeriod
+
+	rolloverPolicy RolloverPolicy
+	rolloverCap    *money.Money
+
+	alertThresholds []int
+	amountVersions  []BudgetAmountVersion
 }
 
-func NewBudget(id string, amount float64, description string, endDate *time.Time) Budget {
+func NewBudget(id string, amount money.Money, description string, endDate *time.Time) Budget {
 	now := time.Now()
 	return &budget{
 		id:          id,
@@ -42,6 +75,9 @@ func NewBudget(id string, amount float64, description string, endDate *time.Time
 		endDate:     endDate,
 		createdAt:   now,
 		updatedAt:   now,
+		period:      BudgetMonthly,
+
+		rolloverPolicy: RolloverNone,
 	}
 }
 
@@ -49,10 +85,53 @@ func (b *budget) ID() string {
 	return b.id
 }
 
-func (b *budget) Amount() float64 {
+func (b *budget) Amount() money.Money {
 	return b.amount
 }
 
+func (b *budget) AmountAt(at time.Time) money.Money {
+	amount := b.amount
+	var current BudgetAmountVersion
+	for _, version := range b.amountVersions {
+		if version.EffectiveFrom().After(at) {
+			continue
+		}
+		if current == nil || version.EffectiveFrom().After(current.EffectiveFrom()) ||
+			(version.EffectiveFrom().Equal(current.EffectiveFrom()) && version.CreatedAt().After(current.CreatedAt())) {
+			current = version
+		}
+	}
+	if current != nil {
+		amount = current.Amount()
+	}
+	return amount
+}
+
+func (b *budget) AmountVersions() []BudgetAmountVersion {
+	return b.amountVersions
+}
+
+func (b *budget) SetAmountVersions(versions []BudgetAmountVersion) {
+	b.amountVersions = versions
+}
+
+func (b *budget) ChangeAmount(version BudgetAmountVersion, now time.Time) error {
+	if b.Status() != BudgetActive {
+		return fmt.Errorf("orçamento %s não está ativo", b.description)
+	}
+
+	currentMonth, _ := MonthRange(now.Year(), now.Month())
+	if version.EffectiveFrom().Before(currentMonth) {
+		return fmt.Errorf("o valor de meses anteriores não pode ser alterado")
+	}
+	if b.endDate != nil && version.EffectiveFrom().After(*b.endDate) {
+		return fmt.Errorf("orçamento %s termina antes de %02d/%d", b.description, version.EffectiveFrom().Month(), version.EffectiveFrom().Year())
+	}
+
+	b.amountVersions = append(b.amountVersions, version)
+	return nil
+}
+
 func (b *budget) Description() string {
 	return b.description
 }
@@ -69,10 +148,67 @@ func (b *budget) UpdatedAt() time.Time {
 	return b.updatedAt
 }
 
+func (b *budget) DeletedAt() *time.Time {
+	return b.deletedAt
+}
+
 func (b *budget) SetEndDate(endDate time.Time) {
 	b.endDate = &endDate
 }
 
+func (b *budget) ParentId() *string {
+	return b.parentId
+}
+
+func (b *budget) SetParentId(parentId *string) {
+	b.parentId = parentId
+}
+
+func (b *budget) Period() BudgetPeriod {
+	return b.period
+}
+
+func (b *budget) SetPeriod(period BudgetPeriod) {
+	b.period = period
+}
+
+func (b *budget) RolloverPolicy() RolloverPolicy {
+	return b.rolloverPolicy
+}
+
+func (b *budget) RolloverCap() *money.Money {
+	return b.rolloverCap
+}
+
+func (b *budget) SetRollover(policy RolloverPolicy, cap *money.Money) error {
+	if policy == RolloverCapped && (cap == nil || !cap.IsPositive()) {
+		return fmt.Errorf("a política capped exige um teto maior que zero")
+	}
+	if policy != RolloverCapped {
+		cap = nil
+	}
+	b.rolloverPolicy = policy
+	b.rolloverCap = cap
+	return nil
+}
+
+func (b *budget) AlertThresholds() []int {
+	return b.alertThresholds
+}
+
+func (b *budget) SetAlertThresholds(thresholds []int) error {
+	thresholds, err := NewAlertThresholds(thresholds)
+	if err != nil {
+		return err
+	}
+	b.alertThresholds = thresholds
+	return nil
+}
+
+func (b *budget) SetDeletedAt(deletedAt *time.Time) {
+	b.deletedAt = deletedAt
+}
+
 func (b *budget) Status() BudgetStatus {
 	if b.endDate != nil && b.endDate.Before(time.Now()) {
 		return BudgetExpired
@@ -0,0 +1,192 @@
+package models
+
+import (
+	"financial-backend/pkg/money"
+	"fmt"
+	"net/mail"
+	"net/url"
+	"sort"
+	"time"
+)
+
+// NotifierChannel é o meio pelo qual os alertas de um orçamento são entregues
+type NotifierChannel string
+
+const (
+	NotifierWebhook NotifierChannel = "webhook"
+	NotifierSMTP    NotifierChannel = "smtp"
+)
+
+// BudgetNotifier é um destino que recebe os alertas de limite de um orçamento
+type BudgetNotifier interface {
+	ID() string
+	BudgetId() string
+	Channel() NotifierChannel
+	// Target é a URL do webhook ou o e-mail do destinatário, conforme o canal
+	Target() string
+	CreatedAt() time.Time
+}
+
+type budgetNotifier struct {
+	id        string
+	budgetId  string
+	channel   NotifierChannel
+	target    string
+	createdAt time.Time
+}
+
+func NewBudgetNotifier(id, budgetId string, channel NotifierChannel, target string, createdAt time.Time) (BudgetNotifier, error) {
+	switch channel {
+	case NotifierWebhook:
+		parsed, err := url.ParseRequestURI(target)
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") {
+			return nil, fmt.Errorf("url de webhook inválida: %s", target)
+		}
+	case NotifierSMTP:
+		parsed, err := mail.ParseAddress(target)
+		if err != nil {
+			return nil, fmt.Errorf("e-mail inválido: %s", target)
+		}
+		// "Nome <a@b>" é aceito, mas só o endereço é gravado, pois é ele que vai no envelope do e-mail
+		target = parsed.Address
+	default:
+		return nil, fmt.Errorf("canal de notificação inválido: %s", channel)
+	}
+
+	return &budgetNotifier{
+		id:        id,
+		budgetId:  budgetId,
+		channel:   channel,
+		target:    target,
+		createdAt: createdAt,
+	}, nil
+}
+
+func (n *budgetNotifier) ID() string {
+	return n.id
+}
+
+func (n *budgetNotifier) BudgetId() string {
+	return n.budgetId
+}
+
+func (n *budgetNotifier) Channel() NotifierChannel {
+	return n.channel
+}
+
+func (n *budgetNotifier) Target() string {
+	return n.target
+}
+
+func (n *budgetNotifier) CreatedAt() time.Time {
+	return n.createdAt
+}
+
+// BudgetAlert registra que um limite do orçamento foi ultrapassado em um período; cada limite dispara uma única vez por período
+type BudgetAlert interface {
+	ID() string
+	BudgetId() string
+	Threshold() int
+	PeriodStart() time.Time
+	Limit() money.Money
+	Spent() money.Money
+	CreatedAt() time.Time
+
+	WithCreatedAt(createdAt time.Time) BudgetAlert
+}
+
+type budgetAlert struct {
+	id          string
+	budgetId    string
+	threshold   int
+	periodStart time.Time
+	limit       money.Money
+	spent       money.Money
+	createdAt   time.Time
+}
+
+func NewBudgetAlert(id, budgetId string, threshold int, periodStart time.Time, limit, spent money.Money) BudgetAlert {
+	return &budgetAlert{
+		id:          id,
+		budgetId:    budgetId,
+		threshold:   threshold,
+		periodStart: periodStart,
+		limit:       limit,
+		spent:       spent,
+		createdAt:   time.Now(),
+	}
+}
+
+func (a *budgetAlert) ID() string {
+	return a.id
+}
+
+func (a *budgetAlert) BudgetId() string {
+	return a.budgetId
+}
+
+func (a *budgetAlert) Threshold() int {
+	return a.threshold
+}
+
+func (a *budgetAlert) PeriodStart() time.Time {
+	return a.periodStart
+}
+
+func (a *budgetAlert) Limit() money.Money {
+	return a.limit
+}
+
+func (a *budgetAlert) Spent() money.Money {
+	return a.spent
+}
+
+func (a *budgetAlert) CreatedAt() time.Time {
+	return a.createdAt
+}
+
+func (a *budgetAlert) WithCreatedAt(createdAt time.Time) BudgetAlert {
+	a.createdAt = createdAt
+	return a
+}
+
+// NewAlertThresholds valida os percentuais de alerta, removendo repetições e ordenando-os
+func NewAlertThresholds(values []int) ([]int, error) {
+	seen := make(map[int]bool, len(values))
+	thresholds := make([]int, 0, len(values))
+	for _, value := range values {
+		if value <= 0 || value > 1000 {
+			return nil, fmt.Errorf("limite de alerta deve estar entre 1%% e 1000%%: %d", value)
+		}
+		if !seen[value] {
+			seen[value] = true
+			thresholds = append(thresholds, value)
+		}
+	}
+	sort.Ints(thresholds)
+	return thresholds, nil
+}
+
+// UsagePercent retorna quanto do limite do período já foi gasto, em percentual
+func UsagePercent(limit, spent money.Money) float64 {
+	if !limit.IsPositive() {
+		if spent.IsPositive() {
+			return 100
+		}
+		return 0
+	}
+	return float64(spent) / float64(limit) * 100
+}
+
+// CrossedThresholds retorna os limites de alerta já atingidos pelo gasto do período
+func CrossedThresholds(thresholds []int, limit, spent money.Money) []int {
+	usage := UsagePercent(limit, spent)
+
+	var crossed []int
+	for _, threshold := range thresholds {
+		if usage >= float64(threshold) {
+			crossed = append(crossed, threshold)
+		}
+	}
+	return crossed
+}
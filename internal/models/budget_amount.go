@@ -0,0 +1,68 @@
+package models
+
+import (
+	"financial-backend/pkg/money"
+	"fmt"
+	"time"
+)
+
+// BudgetAmountVersion é o valor de um orçamento a partir de um mês. Cada mudança de valor gera uma
+// nova versão, de modo que os meses anteriores continuam usando o valor que estava em vigor.
+type BudgetAmountVersion interface {
+	ID() string
+	BudgetId() string
+	Amount() money.Money
+	// EffectiveFrom é o primeiro dia do mês a partir do qual o valor vale
+	EffectiveFrom() time.Time
+	Reason() *string
+	CreatedAt() time.Time
+}
+
+type budgetAmountVersion struct {
+	id            string
+	budgetId      string
+	amount        money.Money
+	effectiveFrom time.Time
+	reason        *string
+	createdAt     time.Time
+}
+
+func NewBudgetAmountVersion(id, budgetId string, amount money.Money, effectiveFrom time.Time, reason *string, createdAt time.Time) (BudgetAmountVersion, error) {
+	if !amount.IsPositive() {
+		return nil, fmt.Errorf("valor do orçamento deve ser maior que zero")
+	}
+
+	first, _ := MonthRange(effectiveFrom.Year(), effectiveFrom.Month())
+	return &budgetAmountVersion{
+		id:            id,
+		budgetId:      budgetId,
+		amount:        amount,
+		effectiveFrom: first,
+		reason:        reason,
+		createdAt:     createdAt,
+	}, nil
+}
+
+func (v *budgetAmountVersion) ID() string {
+	return v.id
+}
+
+func (v *budgetAmountVersion) BudgetId() string {
+	return v.budgetId
+}
+
+func (v *budgetAmountVersion) Amount() money.Money {
+	return v.amount
+}
+
+func (v *budgetAmountVersion) EffectiveFrom() time.Time {
+	return v.effectiveFrom
+}
+
+func (v *budgetAmountVersion) Reason() *string {
+	return v.reason
+}
+
+func (v *budgetAmountVersion) CreatedAt() time.Time {
+	return v.createdAt
+}
@@ -1,6 +1,9 @@
 package models
 
 import (
+	"financial-backend/pkg/money"
+	"fmt"
+	"strings"
 	"time"
 )
 
@@ -13,8 +16,32 @@ const (
 	MovementIncrease MovementType = "increase"
 	MovementDecrease MovementType = "decrease"
 	MovementStart    MovementType = "start"
+	MovementReversal MovementType = "reversal"
+	// MovementRollover leva para o período o saldo do período anterior, conforme a política de rollover do orçamento
+	MovementRollover MovementType = "rollover"
 )
 
+// MovementReversalStrategy define como as movimentações de uma origem removida são desfeitas
+type MovementReversalStrategy string
+
+const (
+	// ReversalVoid apaga as movimentações da origem
+	ReversalVoid MovementReversalStrategy = "void"
+	// ReversalCompensate mantém as movimentações e lança estornos de mesmo valor
+	ReversalCompensate MovementReversalStrategy = "compensate"
+)
+
+func NewMovementReversalStrategy(strategy string) (MovementReversalStrategy, error) {
+	switch MovementReversalStrategy(strategy) {
+	case "":
+		return ReversalVoid, nil
+	case ReversalVoid, ReversalCompensate:
+		return MovementReversalStrategy(strategy), nil
+	default:
+		return "", fmt.Errorf("estratégia de estorno inválida: %s", strategy)
+	}
+}
+
 // BudgetMovementInterface defines the methods for BudgetMovement
 type BudgetMovement interface {
 	ID() string
@@ -24,9 +51,25 @@ type BudgetMovement interface {
 	OriginDescription() *string
 	Month() int
 	Year() int
+	Date() time.Time
 	Type() MovementType
-	Amount() int
+	Amount() money.Money
+	Tags() []string
 	CreatedAt() time.Time
+	CounterpartBudgetId() *string
+	CounterpartBudget() Budget
+	InstallmentId() *string
+
+	// WithTags define as tags da movimentação, herdadas da sua origem
+	WithTags(tags []string) BudgetMovement
+	// WithDate define o dia a que a movimentação se refere, como o vencimento da ocorrência da despesa
+	WithDate(date time.Time) BudgetMovement
+	// WithCreatedAt define o momento em que a movimentação foi gravada, ao reconstruí-la do banco
+	WithCreatedAt(createdAt time.Time) BudgetMovement
+	// WithCounterpart define o orçamento do outro lado de uma transferência
+	WithCounterpart(budgetId string, budget Budget) BudgetMovement
+	// WithInstallment liga a movimentação à parcela da despesa que ela representa
+	WithInstallment(installmentId string) BudgetMovement
 }
 
 // BudgetMovement struct implements BudgetMovementInterface
@@ -38,9 +81,15 @@ type budgetMovement struct {
 	originDescription *string
 	month             int
 	year              int
+	date              time.Time
 	movementType      MovementType
-	amount            int
+	amount            money.Money
+	tags              []string
 	createdAt         time.Time
+
+	counterpartBudgetId *string
+	counterpartBudget   Budget
+	installmentId       *string
 }
 
 // NewBudgetMovement creates a new BudgetMovement instance
@@ -53,11 +102,11 @@ func NewBudgetMovement(
 	month int,
 	year int,
 	movementType MovementType,
-	amount int,
+	amount money.Money,
 ) BudgetMovement {
 	newAmount := amount
-	if (movementType == MovementExpense || movementType == MovementDecrease) && amount > 0 {
-		newAmount = amount * -1
+	if (movementType == MovementExpense || movementType == MovementDecrease) && amount.IsPositive() {
+		newAmount = amount.Neg()
 	}
 	return &budgetMovement{
 		id:                id,
@@ -67,6 +116,7 @@ func NewBudgetMovement(
 		originDescription: originDescription,
 		month:             month,
 		year:              year,
+		date:              dateOnly(PeriodReference(month, year, time.Now())),
 		movementType:      movementType,
 		amount:            newAmount,
 		createdAt:         time.Now(),
@@ -107,13 +157,24 @@ func (bm *budgetMovement) Year() int {
 	return bm.year
 }
 
+// Date returns the day the BudgetMovement refers to; by default today for the current month, otherwise the first day of its month
+func (bm *budgetMovement) Date() time.Time {
+	return bm.date
+}
+
+// WithDate sets the day the BudgetMovement refers to
+func (bm *budgetMovement) WithDate(date time.Time) BudgetMovement {
+	bm.date = dateOnly(date)
+	return bm
+}
+
 // Type returns the type of the BudgetMovement
 func (bm *budgetMovement) Type() MovementType {
 	return bm.movementType
 }
 
 // Amount returns the amount of the BudgetMovement
-func (bm *budgetMovement) Amount() int {
+func (bm *budgetMovement) Amount() money.Money {
 	return bm.amount
 }
 
@@ -121,3 +182,118 @@ func (bm *budgetMovement) Amount() int {
 func (bm *budgetMovement) CreatedAt() time.Time {
 	return bm.createdAt
 }
+
+// WithCreatedAt sets the creation time of the BudgetMovement
+func (bm *budgetMovement) WithCreatedAt(createdAt time.Time) BudgetMovement {
+	bm.createdAt = createdAt
+	return bm
+}
+
+// Tags returns the tags of the BudgetMovement
+func (bm *budgetMovement) Tags() []string {
+	return bm.tags
+}
+
+// WithTags sets the tags of the BudgetMovement
+func (bm *budgetMovement) WithTags(tags []string) BudgetMovement {
+	bm.tags = NormalizeTags(tags)
+	return bm
+}
+
+// CounterpartBudgetId returns the budget on the other side of a transfer
+func (bm *budgetMovement) CounterpartBudgetId() *string {
+	return bm.counterpartBudgetId
+}
+
+// CounterpartBudget returns the budget on the other side of a transfer, when loaded
+func (bm *budgetMovement) CounterpartBudget() Budget {
+	return bm.counterpartBudget
+}
+
+// WithCounterpart sets the budget on the other side of a transfer
+func (bm *budgetMovement) WithCounterpart(budgetId string, budget Budget) BudgetMovement {
+	bm.counterpartBudgetId = &budgetId
+	bm.counterpartBudget = budget
+	return bm
+}
+
+// InstallmentId returns the installment represented by the BudgetMovement, when it comes from an installment expense
+func (bm *budgetMovement) InstallmentId() *string {
+	return bm.installmentId
+}
+
+// WithInstallment sets the installment represented by the BudgetMovement
+func (bm *budgetMovement) WithInstallment(installmentId string) BudgetMovement {
+	bm.installmentId = &installmentId
+	return bm
+}
+
+// NewBudgetTransfer cria o par de movimentações de uma transferência entre orçamentos:
+// o débito na origem e o crédito no destino, ligados pelo mesmo transferId
+func NewBudgetTransfer(transferId string, source, target Budget, amount money.Money, month, year int, reason *string, newId func() string) (BudgetMovement, BudgetMovement, error) {
+	if source.ID() == target.ID() {
+		return nil, nil, fmt.Errorf("orçamento de destino deve ser diferente do de origem")
+	}
+	if !amount.IsPositive() {
+		return nil, nil, fmt.Errorf("valor da transferência deve ser maior que zero")
+	}
+	if month < 1 || month > 12 {
+		return nil, nil, fmt.Errorf("mês inválido: %d", month)
+	}
+
+	debit := NewBudgetMovement(newId(), source.ID(), source, transferId, reason, month, year, MovementTransfer, amount.Neg()).
+		WithCounterpart(target.ID(), target)
+	credit := NewBudgetMovement(newId(), target.ID(), target, transferId, reason, month, year, MovementTransfer, amount).
+		WithCounterpart(source.ID(), source)
+	return debit, credit, nil
+}
+
+// MaxAdjustmentMonths limita quantos meses um único ajuste pode cobrir, já que é gravada uma movimentação por mês
+const MaxAdjustmentMonths = 24
+
+// AdjustmentMonths retorna quantos meses existem de from até to, contando os dois
+func AdjustmentMonths(from, to time.Time) int {
+	return (to.Year()-from.Year())*12 + int(to.Month()) - int(from.Month()) + 1
+}
+
+// NewBudgetAdjustments cria os aumentos ou reduções de um orçamento, um por mês do período informado,
+// ligados pelo mesmo adjustmentId
+func NewBudgetAdjustments(adjustmentId string, budget Budget, movementType MovementType, amount money.Money, reason string, from, to time.Time, newId func() string) ([]BudgetMovement, error) {
+	if movementType != MovementIncrease && movementType != MovementDecrease {
+		return nil, fmt.Errorf("tipo de ajuste inválido: %s", movementType)
+	}
+	if !amount.IsPositive() {
+		return nil, fmt.Errorf("valor do ajuste deve ser maior que zero")
+	}
+	if strings.TrimSpace(reason) == "" {
+		return nil, fmt.Errorf("é necessário informar o motivo do ajuste")
+	}
+	if budget.Status() != BudgetActive {
+		return nil, fmt.Errorf("orçamento %s não está ativo", budget.Description())
+	}
+	if to.Before(from) {
+		return nil, fmt.Errorf("mês final deve ser maior ou igual ao mês inicial")
+	}
+	if AdjustmentMonths(from, to) > MaxAdjustmentMonths {
+		return nil, fmt.Errorf("ajuste não pode cobrir mais de %d meses", MaxAdjustmentMonths)
+	}
+
+	var movements []BudgetMovement
+	for month := from; !month.After(to); month = month.AddDate(0, 1, 0) {
+		if budget.EndDate() != nil && month.After(*budget.EndDate()) {
+			return nil, fmt.Errorf("orçamento %s termina antes de %02d/%d", budget.Description(), month.Month(), month.Year())
+		}
+		movements = append(movements, NewBudgetMovement(
+			newId(),
+			budget.ID(),
+			budget,
+			adjustmentId,
+			&reason,
+			int(month.Month()),
+			month.Year(),
+			movementType,
+			amount,
+		))
+	}
+	return movements, nil
+}
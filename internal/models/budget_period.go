@@ -0,0 +1,62 @@
+package models
+
+import (
+	"fmt"
+	"time"
+)
+
+// BudgetPeriod define de quanto em quanto tempo o valor do orçamento é renovado
+type BudgetPeriod string
+
+const (
+	BudgetWeekly    BudgetPeriod = "weekly"
+	BudgetMonthly   BudgetPeriod = "monthly"
+	BudgetQuarterly BudgetPeriod = "quarterly"
+	BudgetYearly    BudgetPeriod = "yearly"
+)
+
+func NewBudgetPeriod(period string) (BudgetPeriod, error) {
+	switch BudgetPeriod(period) {
+	case "":
+		return BudgetMonthly, nil
+	case BudgetWeekly, BudgetMonthly, BudgetQuarterly, BudgetYearly:
+		return BudgetPeriod(period), nil
+	default:
+		return "", fmt.Errorf("período de orçamento inválido: %s", period)
+	}
+}
+
+// Range retorna o primeiro e o último dia do período que contém ref.
+// A semana começa na segunda-feira, como no date_trunc do postgres.
+func (p BudgetPeriod) Range(ref time.Time) (first, last time.Time) {
+	switch p {
+	case BudgetWeekly:
+		day := time.Date(ref.Year(), ref.Month(), ref.Day(), 0, 0, 0, 0, time.UTC)
+		first = day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+		return first, first.AddDate(0, 0, 6)
+	case BudgetQuarterly:
+		first = time.Date(ref.Year(), ref.Month()-(ref.Month()-1)%3, 1, 0, 0, 0, 0, time.UTC)
+		return first, first.AddDate(0, 3, -1)
+	case BudgetYearly:
+		first = time.Date(ref.Year(), time.January, 1, 0, 0, 0, 0, time.UTC)
+		return first, first.AddDate(1, 0, -1)
+	default:
+		return MonthRange(ref.Year(), ref.Month())
+	}
+}
+
+// Previous retorna uma data dentro do período anterior ao que contém ref
+func (p BudgetPeriod) Previous(ref time.Time) time.Time {
+	first, _ := p.Range(ref)
+	return first.AddDate(0, 0, -1)
+}
+
+// PeriodReference escolhe a data usada para localizar o período de um mês consultado:
+// o momento atual quando o mês é o corrente e o primeiro dia do mês nos demais casos
+func PeriodReference(month, year int, now time.Time) time.Time {
+	if int(now.Month()) == month && now.Year() == year {
+		return now
+	}
+	first, _ := MonthRange(year, time.Month(month))
+	return first
+}
@@ -0,0 +1,49 @@
+package models
+
+import (
+	"financial-backend/pkg/money"
+	"fmt"
+)
+
+// RolloverPolicy define o que acontece com o saldo de um período quando o orçamento vira para o próximo
+type RolloverPolicy string
+
+const (
+	// RolloverNone descarta o saldo do período anterior
+	RolloverNone RolloverPolicy = "none"
+	// RolloverFull leva o saldo do período anterior inteiro, seja sobra ou estouro
+	RolloverFull RolloverPolicy = "full"
+	// RolloverCapped leva a sobra limitada ao teto do orçamento; estouros são levados inteiros
+	RolloverCapped RolloverPolicy = "capped"
+	// RolloverDebt leva apenas o estouro, como dívida do período seguinte
+	RolloverDebt RolloverPolicy = "debt"
+)
+
+func NewRolloverPolicy(policy string) (RolloverPolicy, error) {
+	switch RolloverPolicy(policy) {
+	case "":
+		return RolloverNone, nil
+	case RolloverNone, RolloverFull, RolloverCapped, RolloverDebt:
+		return RolloverPolicy(policy), nil
+	default:
+		return "", fmt.Errorf("política de rollover inválida: %s", policy)
+	}
+}
+
+// RolloverAmount calcula quanto do saldo do período anterior vai para o período seguinte segundo a política
+func RolloverAmount(policy RolloverPolicy, cap *money.Money, balance money.Money) money.Money {
+	switch policy {
+	case RolloverFull:
+		return balance
+	case RolloverCapped:
+		if cap != nil && balance > *cap {
+			return *cap
+		}
+		return balance
+	case RolloverDebt:
+		if balance.IsNegative() {
+			return balance
+		}
+	}
+	return money.Zero
+}
@@ -0,0 +1,52 @@
+package models
+
+import (
+	"financial-backend/pkg/money"
+	"time"
+)
+
+// BudgetStatementLine é uma movimentação do extrato com o saldo do orçamento logo depois dela
+type BudgetStatementLine struct {
+	Movement BudgetMovement
+	Balance  money.Money
+}
+
+// BudgetStatement é o extrato de um orçamento em um período: o valor de abertura, as movimentações
+// com o saldo corrente e o saldo de fechamento
+type BudgetStatement struct {
+	Budget      Budget
+	PeriodStart time.Time
+	PeriodEnd   time.Time
+	// Started indica se a movimentação de início já foi gerada; sem ela, a abertura é o valor em vigor no período
+	Started bool
+	Opening money.Money
+	Lines   []BudgetStatementLine
+	Closing money.Money
+}
+
+// NewBudgetStatement monta o extrato do período que contém at a partir das movimentações do período em ordem cronológica
+func NewBudgetStatement(budget Budget, movements []BudgetMovement, at time.Time) BudgetStatement {
+	statement := BudgetStatement{Budget: budget}
+	statement.PeriodStart, statement.PeriodEnd = budget.Period().Range(at)
+
+	for _, movement := range movements {
+		if movement.Type() == MovementStart {
+			statement.Started = true
+			statement.Opening = statement.Opening.Add(movement.Amount())
+		}
+	}
+	if !statement.Started {
+		statement.Opening = budget.AmountAt(statement.PeriodStart)
+	}
+
+	balance := statement.Opening
+	for _, movement := range movements {
+		if movement.Type() == MovementStart {
+			continue
+		}
+		balance = balance.Add(movement.Amount())
+		statement.Lines = append(statement.Lines, BudgetStatementLine{Movement: movement, Balance: balance})
+	}
+	statement.Closing = balance
+	return statement
+}
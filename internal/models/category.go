@@ -0,0 +1,63 @@
+package models
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+type Category interface {
+	ID() string
+	Name() string
+	ParentId() *string
+	CreatedAt() time.Time
+	UpdatedAt() time.Time
+}
+
+type category struct {
+	id        string
+	name      string
+	parentId  *string
+	createdAt time.Time
+	updatedAt time.Time
+}
+
+func NewCategory(id, name string, parentId *string) (Category, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, errors.New("nome da categoria é obrigatório")
+	}
+
+	if parentId != nil && *parentId == id {
+		return nil, errors.New("categoria não pode ser filha dela mesma")
+	}
+
+	now := time.Now()
+	return &category{
+		id:        id,
+		name:      strings.ToUpper(name),
+		parentId:  parentId,
+		createdAt: now,
+		updatedAt: now,
+	}, nil
+}
+
+func (c *category) ID() string {
+	return c.id
+}
+
+func (c *category) Name() string {
+	return c.name
+}
+
+func (c *category) ParentId() *string {
+	return c.parentId
+}
+
+func (c *category) CreatedAt() time.Time {
+	return c.createdAt
+}
+
+func (c *category) UpdatedAt() time.Time {
+	return c.updatedAt
+}
@@ -0,0 +1,100 @@
+package models
+
+import (
+	"errors"
+	"financial-backend/pkg/money"
+	"strings"
+	"time"
+)
+
+type CreditCard interface {
+	ID() string
+	Name() string
+	ClosingDay() int
+	DueDay() int
+	Limit() money.Money
+	CreatedAt() time.Time
+	UpdatedAt() time.Time
+
+	StatementDueDate(purchaseDate time.Time) time.Time
+}
+
+type creditCard struct {
+	id         string
+	name       string
+	closingDay int
+	dueDay     int
+	limit      money.Money
+	createdAt  time.Time
+	updatedAt  time.Time
+}
+
+func NewCreditCard(id, name string, closingDay, dueDay int, limit money.Money) (CreditCard, error) {
+	if closingDay < 1 || closingDay > 31 {
+		return nil, errors.New("dia de fechamento do cartão deve estar entre 1 e 31")
+	}
+
+	if dueDay < 1 || dueDay > 31 {
+		return nil, errors.New("dia de vencimento do cartão deve estar entre 1 e 31")
+	}
+
+	if limit.IsNegative() {
+		return nil, errors.New("limite do cartão não pode ser negativo")
+	}
+
+	now := time.Now()
+	return &creditCard{
+		id:         id,
+		name:       strings.ToUpper(name),
+		closingDay: closingDay,
+		dueDay:     dueDay,
+		limit:      limit,
+		createdAt:  now,
+		updatedAt:  now,
+	}, nil
+}
+
+func (c *creditCard) ID() string {
+	return c.id
+}
+
+func (c *creditCard) Name() string {
+	return c.name
+}
+
+func (c *creditCard) ClosingDay() int {
+	return c.closingDay
+}
+
+func (c *creditCard) DueDay() int {
+	return c.dueDay
+}
+
+func (c *creditCard) Limit() money.Money {
+	return c.limit
+}
+
+func (c *creditCard) CreatedAt() time.Time {
+	return c.createdAt
+}
+
+func (c *creditCard) UpdatedAt() time.Time {
+	return c.updatedAt
+}
+
+// StatementDueDate retorna o vencimento da fatura em que a compra entra.
+// Compras a partir do dia de fechamento vão para a fatura seguinte; quando o vencimento
+// é menor ou igual ao fechamento, a fatura vence no mês seguinte ao fechamento.
+func (c *creditCard) StatementDueDate(purchaseDate time.Time) time.Time {
+	closingMonth := time.Date(purchaseDate.Year(), purchaseDate.Month(), 1, 0, 0, 0, 0, time.UTC)
+	if !dateOnly(purchaseDate).Before(DayInMonth(closingMonth.Year(), closingMonth.Month(), c.closingDay)) {
+		closingMonth = closingMonth.AddDate(0, 1, 0)
+	}
+
+	dueMonth := closingMonth
+	if c.dueDay <= c.closingDay {
+		dueMonth = dueMonth.AddDate(0, 1, 0)
+	}
+
+	return DayInMonth(dueMonth.Year(), dueMonth.Month(), c.dueDay)
+}
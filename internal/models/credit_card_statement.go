@@ -0,0 +1,223 @@
+package models
+
+import (
+	"errors"
+	"financial-backend/pkg/money"
+	"sort"
+	"time"
+)
+
+type CreditCardPayment interface {
+	ID() string
+	CardId() string
+	Year() int
+	Month() int
+	Amount() money.Money
+	PaidAt() time.Time
+	CreatedAt() time.Time
+}
+
+type creditCardPayment struct {
+	id        string
+	cardId    string
+	year      int
+	month     int
+	amount    money.Money
+	paidAt    time.Time
+	createdAt time.Time
+}
+
+func NewCreditCardPayment(id, cardId string, year, month int, amount money.Money, paidAt time.Time) (CreditCardPayment, error) {
+	if !amount.IsPositive() {
+		return nil, errors.New("valor do pagamento da fatura deve ser maior que zero")
+	}
+
+	return &creditCardPayment{
+		id:        id,
+		cardId:    cardId,
+		year:      year,
+		month:     month,
+		amount:    amount,
+		paidAt:    paidAt,
+		createdAt: time.Now(),
+	}, nil
+}
+
+func (p *creditCardPayment) ID() string {
+	return p.id
+}
+
+func (p *creditCardPayment) CardId() string {
+	return p.cardId
+}
+
+func (p *creditCardPayment) Year() int {
+	return p.year
+}
+
+func (p *creditCardPayment) Month() int {
+	return p.month
+}
+
+func (p *creditCardPayment) Amount() money.Money {
+	return p.amount
+}
+
+func (p *creditCardPayment) PaidAt() time.Time {
+	return p.paidAt
+}
+
+func (p *creditCardPayment) CreatedAt() time.Time {
+	return p.createdAt
+}
+
+// StatementItem é uma parcela (ou cobrança recorrente) de uma despesa dentro da fatura
+type StatementItem struct {
+	Expense    Expense
+	Occurrence Occurrence
+	// Installment é a parcela gravada que originou o item, quando a despesa é parcelada
+	Installment ExpenseInstallment
+}
+
+// CreditCardStatement é a fatura de um cartão, identificada pelo mês de vencimento
+type CreditCardStatement interface {
+	Card() CreditCard
+	Year() int
+	Month() int
+	ClosingDate() time.Time
+	DueDate() time.Time
+	Items() []StatementItem
+	Payments() []CreditCardPayment
+	Total() money.Money
+	PaidAmount() money.Money
+	Paid() bool
+	// ItemPaid indica se o item foi pago: pela situação da parcela, quando houver, ou pela quitação da fatura
+	ItemPaid(item StatementItem) bool
+	// CoveredInstallments retorna as parcelas ainda abertas que os pagamentos cobrem, na ordem dos itens da fatura
+	CoveredInstallments() []ExpenseInstallment
+}
+
+type creditCardStatement struct {
+	card     CreditCard
+	year     int
+	month    int
+	items    []StatementItem
+	payments []CreditCardPayment
+}
+
+// NewCreditCardStatement monta a fatura do mês com o que vence nele. Despesas parceladas entram pelas parcelas
+// gravadas, já que elas podem ter sido reagendadas ou canceladas; as demais entram pela agenda da despesa.
+// expenses precisa conter as despesas de todas as parcelas informadas.
+func NewCreditCardStatement(card CreditCard, year, month int, expenses []Expense, installments []ExpenseInstallment, payments []CreditCardPayment) CreditCardStatement {
+	first, last := MonthRange(year, time.Month(month))
+
+	var items []StatementItem
+	byId := make(map[string]Expense, len(expenses))
+	for _, expense := range expenses {
+		byId[expense.Id()] = expense
+		if expense.Installments() != nil {
+			continue
+		}
+		for _, occurrence := range NewExpenseSchedule(expense).Occurrences(first, last) {
+			items = append(items, StatementItem{Expense: expense, Occurrence: occurrence})
+		}
+	}
+
+	for _, installment := range installments {
+		expense, ok := byId[installment.ExpenseId()]
+		dueDate := dateOnly(installment.DueDate())
+		if !ok || installment.Status() == InstallmentCancelled || dueDate.Before(first) || dueDate.After(last) {
+			continue
+		}
+		items = append(items, StatementItem{
+			Expense:     expense,
+			Occurrence:  Occurrence{Number: installment.Number(), Date: dueDate, Amount: installment.Amount()},
+			Installment: installment,
+		})
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].Occurrence.Date.Before(items[j].Occurrence.Date)
+	})
+
+	return &creditCardStatement{
+		card:     card,
+		year:     year,
+		month:    month,
+		items:    items,
+		payments: payments,
+	}
+}
+
+func (s *creditCardStatement) Card() CreditCard {
+	return s.card
+}
+
+func (s *creditCardStatement) Year() int {
+	return s.year
+}
+
+func (s *creditCardStatement) Month() int {
+	return s.month
+}
+
+// ClosingDate retorna o fechamento da fatura: no mês do vencimento, ou no anterior quando o vencimento é antes do fechamento
+func (s *creditCardStatement) ClosingDate() time.Time {
+	month := time.Date(s.year, time.Month(s.month), 1, 0, 0, 0, 0, time.UTC)
+	if s.card.DueDay() <= s.card.ClosingDay() {
+		month = month.AddDate(0, -1, 0)
+	}
+	return DayInMonth(month.Year(), month.Month(), s.card.ClosingDay())
+}
+
+func (s *creditCardStatement) DueDate() time.Time {
+	return DayInMonth(s.year, time.Month(s.month), s.card.DueDay())
+}
+
+func (s *creditCardStatement) Items() []StatementItem {
+	return s.items
+}
+
+func (s *creditCardStatement) Payments() []CreditCardPayment {
+	return s.payments
+}
+
+func (s *creditCardStatement) Total() (total money.Money) {
+	for _, item := range s.items {
+		total = total.Add(item.Occurrence.Amount)
+	}
+	return
+}
+
+func (s *creditCardStatement) PaidAmount() (paid money.Money) {
+	for _, payment := range s.payments {
+		paid = paid.Add(payment.Amount())
+	}
+	return
+}
+
+// Paid indica se os pagamentos registrados cobrem o total da fatura
+func (s *creditCardStatement) Paid() bool {
+	return len(s.payments) > 0 && s.PaidAmount() >= s.Total()
+}
+
+func (s *creditCardStatement) ItemPaid(item StatementItem) bool {
+	if item.Installment != nil {
+		return item.Installment.Status() == InstallmentPaid
+	}
+	return s.Paid()
+}
+
+func (s *creditCardStatement) CoveredInstallments() (installments []ExpenseInstallment) {
+	remaining := s.PaidAmount()
+	for _, item := range s.items {
+		if remaining < item.Occurrence.Amount {
+			break
+		}
+		remaining = remaining.Sub(item.Occurrence.Amount)
+		if item.Installment != nil && item.Installment.Open() {
+			installments = append(installments, item.Installment)
+		}
+	}
+	return
+}
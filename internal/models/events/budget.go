@@ -0,0 +1,31 @@
+package events
+
+import (
+	"context"
+	"financial-backend/internal/models"
+	"time"
+)
+
+type BudgetAdjustedEvent struct {
+	Budget    models.Budget
+	Movements []models.BudgetMovement
+	Reason    string
+	Context   context.Context
+}
+
+func (e *BudgetAdjustedEvent) EventName() string {
+	return "BudgetAdjusted"
+}
+
+// BudgetThresholdCrossedEvent é publicado na primeira vez em que o gasto de um período atinge um limite de alerta do orçamento
+type BudgetThresholdCrossedEvent struct {
+	Budget    models.Budget
+	Alert     models.BudgetAlert
+	PeriodEnd time.Time
+	Notifiers []models.BudgetNotifier
+	Context   context.Context
+}
+
+func (e *BudgetThresholdCrossedEvent) EventName() string {
+	return "BudgetThresholdCrossed"
+}
@@ -13,3 +13,43 @@ type ExpenseCreatedEvent struct {
 func (e *ExpenseCreatedEvent) EventName() string {
 	return "ExpenseCreated"
 }
+
+type ExpenseUpdatedEvent struct {
+	Previous models.Expense
+	Expense  models.Expense
+	Context  context.Context
+}
+
+func (e *ExpenseUpdatedEvent) EventName() string {
+	return "ExpenseUpdated"
+}
+
+type ExpenseDeletedEvent struct {
+	Expense        models.Expense
+	Strategy       models.MovementReversalStrategy
+	KeepPastMonths bool
+	Context        context.Context
+}
+
+func (e *ExpenseDeletedEvent) EventName() string {
+	return "ExpenseDeleted"
+}
+
+type ExpenseRestoredEvent struct {
+	Expense models.Expense
+	Context context.Context
+}
+
+func (e *ExpenseRestoredEvent) EventName() string {
+	return "ExpenseRestored"
+}
+
+type ExpenseOverdueEvent struct {
+	Expense    models.Expense
+	Occurrence models.Occurrence
+	Context    context.Context
+}
+
+func (e *ExpenseOverdueEvent) EventName() string {
+	return "ExpenseOverdue"
+}
@@ -1,6 +1,7 @@
 package models
 
 import (
+	"financial-backend/pkg/money"
 	"fmt"
 	"time"
 )
@@ -9,6 +10,18 @@ type ExpenseType string
 type ExpenseRecurrency string
 type ExpenseMethod string
 
+// NewExpenseRecurrency valida a recorrência informada
+func NewExpenseRecurrency(recurrency string) (ExpenseRecurrency, error) {
+	switch ExpenseRecurrency(recurrency) {
+	case ExpenseRecurrencyMonthly, ExpenseRecurrencyWeekly, ExpenseRecurrencyDaily,
+		ExpenseRecurrencyBiweekly, ExpenseRecurrencyBimonthly, ExpenseRecurrencyQuarterly,
+		ExpenseRecurrencyYearly, ExpenseRecurrencyLastBusinessDay, ExpenseRecurrencyCustom:
+		return ExpenseRecurrency(recurrency), nil
+	default:
+		return "", fmt.Errorf("recorrência inválida: %s", recurrency)
+	}
+}
+
 const (
 	ExpenseTypeRecurring ExpenseType = "recurring"
 	ExpenseTypeSingle    ExpenseType = "single"
@@ -18,6 +31,14 @@ const (
 	ExpenseRecurrencyMonthly ExpenseRecurrency = "monthly"
 	ExpenseRecurrencyWeekly  ExpenseRecurrency = "weekly"
 	ExpenseRecurrencyDaily   ExpenseRecurrency = "daily"
+
+	ExpenseRecurrencyBiweekly        ExpenseRecurrency = "biweekly"
+	ExpenseRecurrencyBimonthly       ExpenseRecurrency = "bimonthly"
+	ExpenseRecurrencyQuarterly       ExpenseRecurrency = "quarterly"
+	ExpenseRecurrencyYearly          ExpenseRecurrency = "yearly"
+	ExpenseRecurrencyLastBusinessDay ExpenseRecurrency = "last_business_day"
+	// ExpenseRecurrencyCustom indica que a agenda vem de uma RRULE (RFC 5545)
+	ExpenseRecurrencyCustom ExpenseRecurrency = "custom"
 )
 
 const (
@@ -29,51 +50,109 @@ const (
 type Expense interface {
 	Id() string
 	Description() string
-	Amount() float64
+	Amount() money.Money
 	Type() ExpenseType
 	Recurrency() *ExpenseRecurrency
+	Rrule() *string
 	Method() ExpenseMethod
 	Installments() *int
 	DueDay() int
 	BudgetId() *string
 	Budget() *Budget
+	CardId() *string
+	CategoryId() *string
+	Tags() []string
+	Allocations() []ExpenseAllocation
+	Split() *ExpenseSplit
 	StartDate() time.Time
 	EndDate() *time.Time
+	DeletedAt() *time.Time
+
+	// WithSplit define quem pagou e como a despesa é dividida entre os membros
+	WithSplit(split *ExpenseSplit) Expense
+	// WithDeletedAt marca a despesa como excluída; nil indica uma despesa ativa
+	WithDeletedAt(deletedAt *time.Time) Expense
 }
 
 // Expense representa o modelo de domínio de despesa com suas regras de negócio
 type expense struct {
 	id           string
 	description  string
-	amount       float64
+	amount       money.Money
 	expenseType  ExpenseType
 	recurrency   *ExpenseRecurrency
+	rrule        *string
 	method       ExpenseMethod
 	installments *int
 	dueDay       int
 	budgetId     *string
 	budget       *Budget
+	cardId       *string
+	categoryId   *string
+	tags         []string
+	allocations  []ExpenseAllocation
+	split        *ExpenseSplit
 	startDate    time.Time
 	endDate      *time.Time
+	deletedAt    *time.Time
 }
 
 func NewExpense(
 	id, description string,
-	amount float64,
+	amount money.Money,
 	expenseType string,
 	budgetId,
-	recurrency *string,
+	cardId,
+	categoryId,
+	recurrency,
+	rrule *string,
 	method string,
 	installments *int,
 	dueDay int,
 	startDate time.Time,
 	endDate *time.Time,
+	tags []string,
+	allocations []ExpenseAllocation,
 	budget *Budget,
 ) (Expense, error) {
+	// a agenda só aplica a rrule na recorrência personalizada; sem recorrência, a rrule a define como personalizada
+	if err := validateRRule(rrule, startDate, recurrency == nil || ExpenseRecurrency(*recurrency) == ExpenseRecurrencyCustom); err != nil {
+		return nil, err
+	}
+	if rrule != nil && recurrency == nil {
+		custom := string(ExpenseRecurrencyCustom)
+		recurrency = &custom
+	}
+
+	if recurrency != nil {
+		if _, err := NewExpenseRecurrency(*recurrency); err != nil {
+			return nil, err
+		}
+	}
+
+	if cardId != nil && method != string(ExpenseMethodCreditCard) {
+		return nil, fmt.Errorf("apenas despesas no cartão de crédito podem ter um cartão associado")
+	}
+
 	if expenseType == string(ExpenseTypeRecurring) && recurrency == nil {
 		return nil, fmt.Errorf("quando o tipo de despesa é recorrente, é necessário ter preencher a recorencia")
 	}
 
+	if recurrency != nil && ExpenseRecurrency(*recurrency) == ExpenseRecurrencyCustom && rrule == nil {
+		return nil, fmt.Errorf("recorrência personalizada exige uma rrule")
+	}
+
+	allocations, err := ResolveAllocations(amount, allocations)
+	if err != nil {
+		return nil, err
+	}
+
+	// Com alocações, o orçamento principal da despesa é o da primeira alocação
+	if len(allocations) > 0 && (budgetId == nil || *budgetId != allocations[0].BudgetId) {
+		budgetId = &allocations[0].BudgetId
+		budget = nil
+	}
+
 	var expenseRecurrency *ExpenseRecurrency
 
 	if recurrency == nil {
@@ -90,6 +169,7 @@ func NewExpense(
 		amount:       amount,
 		expenseType:  ExpenseType(expenseType),
 		recurrency:   expenseRecurrency,
+		rrule:        rrule,
 		method:       ExpenseMethod(method),
 		installments: installments,
 		dueDay:       dueDay,
@@ -97,6 +177,10 @@ func NewExpense(
 		startDate:    startDate,
 		endDate:      endDate,
 		budget:       budget,
+		cardId:       cardId,
+		categoryId:   categoryId,
+		tags:         NormalizeTags(tags),
+		allocations:  allocations,
 	}, nil
 }
 
@@ -108,7 +192,7 @@ func (e *expense) Description() string {
 	return e.description
 }
 
-func (e *expense) Amount() float64 {
+func (e *expense) Amount() money.Money {
 	return e.amount
 }
 
@@ -120,6 +204,10 @@ func (e *expense) Recurrency() *ExpenseRecurrency {
 	return e.recurrency
 }
 
+func (e *expense) Rrule() *string {
+	return e.rrule
+}
+
 func (e *expense) Method() ExpenseMethod {
 	return e.method
 }
@@ -140,6 +228,33 @@ func (e *expense) BudgetId() *string {
 	return e.budgetId
 }
 
+func (e *expense) CardId() *string {
+	return e.cardId
+}
+
+func (e *expense) CategoryId() *string {
+	return e.categoryId
+}
+
+func (e *expense) Tags() []string {
+	return e.tags
+}
+
+// Allocations retorna a divisão da despesa entre orçamentos; vazia quando a despesa usa um único orçamento
+func (e *expense) Allocations() []ExpenseAllocation {
+	return e.allocations
+}
+
+// Split retorna a divisão da despesa entre membros; nil quando a despesa não é compartilhada
+func (e *expense) Split() *ExpenseSplit {
+	return e.split
+}
+
+func (e *expense) WithSplit(split *ExpenseSplit) Expense {
+	e.split = split
+	return e
+}
+
 func (e *expense) DueDay() int {
 	return e.dueDay
 }
@@ -147,3 +262,12 @@ func (e *expense) DueDay() int {
 func (e *expense) EndDate() *time.Time {
 	return e.endDate
 }
+
+func (e *expense) DeletedAt() *time.Time {
+	return e.deletedAt
+}
+
+func (e *expense) WithDeletedAt(deletedAt *time.Time) Expense {
+	e.deletedAt = deletedAt
+	return e
+}
@@ -0,0 +1,60 @@
+package models
+
+import (
+	"errors"
+	"financial-backend/pkg/money"
+	"fmt"
+)
+
+// ExpenseAllocation é a parte de uma despesa destinada a um orçamento, por valor ou por percentual do total
+type ExpenseAllocation struct {
+	BudgetId   string
+	Amount     money.Money
+	Percentage *float64
+}
+
+// ResolveAllocations calcula o valor das alocações por percentual e garante que a soma feche com o total.
+// A diferença de arredondamento dos percentuais fica com a última alocação por percentual.
+func ResolveAllocations(total money.Money, allocations []ExpenseAllocation) ([]ExpenseAllocation, error) {
+	if len(allocations) == 0 {
+		return nil, nil
+	}
+
+	resolved := make([]ExpenseAllocation, len(allocations))
+	seen := map[string]bool{}
+	lastPercentage := -1
+	var sum money.Money
+
+	for i, allocation := range allocations {
+		if allocation.BudgetId == "" {
+			return nil, errors.New("toda alocação precisa de um orçamento")
+		}
+		if seen[allocation.BudgetId] {
+			return nil, fmt.Errorf("orçamento %s aparece em mais de uma alocação", allocation.BudgetId)
+		}
+		seen[allocation.BudgetId] = true
+
+		if allocation.Percentage != nil {
+			if *allocation.Percentage <= 0 || *allocation.Percentage > 100 {
+				return nil, errors.New("percentual da alocação deve estar entre 0 e 100")
+			}
+			allocation.Amount = total.Percent(*allocation.Percentage)
+			lastPercentage = i
+		} else if !allocation.Amount.IsPositive() {
+			return nil, errors.New("valor da alocação deve ser maior que zero")
+		}
+
+		resolved[i] = allocation
+		sum = sum.Add(allocation.Amount)
+	}
+
+	if diff := total.Sub(sum); lastPercentage >= 0 && diff.Abs().Cents() <= int64(len(allocations)) {
+		resolved[lastPercentage].Amount = resolved[lastPercentage].Amount.Add(diff)
+		sum = total
+	}
+
+	if sum != total {
+		return nil, fmt.Errorf("a soma das alocações (%s) deve ser igual ao valor da despesa (%s)", sum, total)
+	}
+	return resolved, nil
+}
@@ -0,0 +1,161 @@
+package models
+
+import (
+	"errors"
+	"financial-backend/pkg/money"
+	"time"
+)
+
+type InstallmentStatus string
+
+const (
+	InstallmentPending   InstallmentStatus = "pending"
+	InstallmentPaid      InstallmentStatus = "paid"
+	InstallmentOverdue   InstallmentStatus = "overdue"
+	InstallmentCancelled InstallmentStatus = "cancelled"
+)
+
+type ExpenseInstallment interface {
+	ID() string
+	ExpenseId() string
+	Number() int
+	DueDate() time.Time
+	Amount() money.Money
+	Status() InstallmentStatus
+	PaidAt() *time.Time
+	Open() bool
+	// Rescheduled indica que o vencimento foi alterado à mão e não deve ser refeito a partir da agenda da despesa
+	Rescheduled() bool
+
+	WithRescheduled(rescheduled bool) ExpenseInstallment
+
+	Pay(paidAt time.Time) error
+	Reschedule(dueDate time.Time) error
+	Cancel() error
+}
+
+type expenseInstallment struct {
+	id        string
+	expenseId string
+	number    int
+	dueDate   time.Time
+	amount    money.Money
+	status    InstallmentStatus
+	paidAt    *time.Time
+
+	rescheduled bool
+}
+
+func NewExpenseInstallment(id, expenseId string, number int, dueDate time.Time, amount money.Money, status InstallmentStatus, paidAt *time.Time) ExpenseInstallment {
+	if status == "" {
+		status = InstallmentPending
+	}
+
+	return &expenseInstallment{
+		id:        id,
+		expenseId: expenseId,
+		number:    number,
+		dueDate:   dueDate,
+		amount:    amount,
+		status:    status,
+		paidAt:    paidAt,
+	}
+}
+
+func (i *expenseInstallment) ID() string {
+	return i.id
+}
+
+func (i *expenseInstallment) ExpenseId() string {
+	return i.expenseId
+}
+
+func (i *expenseInstallment) Number() int {
+	return i.number
+}
+
+func (i *expenseInstallment) DueDate() time.Time {
+	return i.dueDate
+}
+
+func (i *expenseInstallment) Amount() money.Money {
+	return i.amount
+}
+
+// Status retorna a situação da parcela; parcelas pendentes com vencimento passado ficam em atraso
+func (i *expenseInstallment) Status() InstallmentStatus {
+	if (i.status == InstallmentPending || i.status == InstallmentOverdue) && dateOnly(i.dueDate).Before(dateOnly(time.Now())) {
+		return InstallmentOverdue
+	}
+	if i.status == InstallmentOverdue {
+		return InstallmentPending
+	}
+	return i.status
+}
+
+func (i *expenseInstallment) PaidAt() *time.Time {
+	return i.paidAt
+}
+
+func (i *expenseInstallment) Rescheduled() bool {
+	return i.rescheduled
+}
+
+func (i *expenseInstallment) WithRescheduled(rescheduled bool) ExpenseInstallment {
+	i.rescheduled = rescheduled
+	return i
+}
+
+// Open indica se a parcela ainda precisa ser paga
+func (i *expenseInstallment) Open() bool {
+	return i.status != InstallmentPaid && i.status != InstallmentCancelled
+}
+
+func (i *expenseInstallment) Pay(paidAt time.Time) error {
+	if !i.Open() {
+		return errors.New("apenas parcelas pendentes ou em atraso podem ser pagas")
+	}
+	i.status = InstallmentPaid
+	i.paidAt = &paidAt
+	return nil
+}
+
+func (i *expenseInstallment) Reschedule(dueDate time.Time) error {
+	if !i.Open() {
+		return errors.New("apenas parcelas pendentes ou em atraso podem ser reagendadas")
+	}
+	i.dueDate = dueDate
+	i.status = InstallmentPending
+	i.rescheduled = true
+	return nil
+}
+
+func (i *expenseInstallment) Cancel() error {
+	if !i.Open() {
+		return errors.New("apenas parcelas pendentes ou em atraso podem ser canceladas")
+	}
+	i.status = InstallmentCancelled
+	return nil
+}
+
+// BuildExpenseInstallments gera as parcelas de uma despesa parcelada a partir da sua agenda
+func BuildExpenseInstallments(expense Expense, newId func() string) (installments []ExpenseInstallment) {
+	if expense.Installments() == nil {
+		return
+	}
+
+	first, _ := MonthRange(expense.StartDate().Year(), expense.StartDate().Month())
+	last := first.AddDate(0, *expense.Installments()+1, 0)
+	for _, occurrence := range NewExpenseSchedule(expense).Occurrences(first, last) {
+		installments = append(installments, NewExpenseInstallment(
+			newId(),
+			expense.Id(),
+			occurrence.Number,
+			occurrence.Date,
+			occurrence.Amount,
+			InstallmentPending,
+			nil,
+		))
+	}
+	return
+}
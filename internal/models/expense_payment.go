@@ -0,0 +1,118 @@
+package models
+
+import (
+	"errors"
+	"financial-backend/pkg/money"
+	"sort"
+	"time"
+)
+
+// ExpensePayment registra o pagamento de uma ocorrência de despesa (boleto, Pix, débito...)
+type ExpensePayment interface {
+	ID() string
+	ExpenseId() string
+	OccurrenceDate() time.Time
+	Amount() money.Money
+	PaidAt() time.Time
+	Note() *string
+	CreatedAt() time.Time
+}
+
+type expensePayment struct {
+	id             string
+	expenseId      string
+	occurrenceDate time.Time
+	amount         money.Money
+	paidAt         time.Time
+	note           *string
+	createdAt      time.Time
+}
+
+func NewExpensePayment(id, expenseId string, occurrenceDate time.Time, amount money.Money, paidAt time.Time, note *string) (ExpensePayment, error) {
+	if !amount.IsPositive() {
+		return nil, errors.New("valor pago deve ser maior que zero")
+	}
+
+	return &expensePayment{
+		id:             id,
+		expenseId:      expenseId,
+		occurrenceDate: dateOnly(occurrenceDate),
+		amount:         amount,
+		paidAt:         paidAt,
+		note:           note,
+		createdAt:      time.Now(),
+	}, nil
+}
+
+func (p *expensePayment) ID() string {
+	return p.id
+}
+
+func (p *expensePayment) ExpenseId() string {
+	return p.expenseId
+}
+
+func (p *expensePayment) OccurrenceDate() time.Time {
+	return p.occurrenceDate
+}
+
+func (p *expensePayment) Amount() money.Money {
+	return p.amount
+}
+
+func (p *expensePayment) PaidAt() time.Time {
+	return p.paidAt
+}
+
+func (p *expensePayment) Note() *string {
+	return p.note
+}
+
+func (p *expensePayment) CreatedAt() time.Time {
+	return p.createdAt
+}
+
+// TracksPayments indica se as ocorrências da despesa são pagas individualmente.
+// Compras no cartão são pagas pela fatura e despesas parceladas pelas suas parcelas.
+func TracksPayments(expense Expense) bool {
+	return expense.Method() != ExpenseMethodCreditCard && expense.Installments() == nil
+}
+
+// OverdueOccurrence é uma ocorrência vencida e sem pagamento registrado
+type OverdueOccurrence struct {
+	Expense    Expense
+	Occurrence Occurrence
+}
+
+// FindOverdueOccurrences retorna as ocorrências com vencimento entre from e o dia anterior a today que não foram pagas
+func FindOverdueOccurrences(expenses []Expense, payments []ExpensePayment, from, today time.Time) (overdue []OverdueOccurrence) {
+	to := dateOnly(today).AddDate(0, 0, -1)
+	if to.Before(dateOnly(from)) {
+		return
+	}
+
+	paid := map[string]bool{}
+	for _, payment := range payments {
+		paid[paymentKey(payment.ExpenseId(), payment.OccurrenceDate())] = true
+	}
+
+	for _, expense := range expenses {
+		if !TracksPayments(expense) {
+			continue
+		}
+		for _, occurrence := range NewExpenseSchedule(expense).Occurrences(from, to) {
+			if !paid[paymentKey(expense.Id(), occurrence.Date)] {
+				overdue = append(overdue, OverdueOccurrence{Expense: expense, Occurrence: occurrence})
+			}
+		}
+	}
+
+	sort.SliceStable(overdue, func(i, j int) bool {
+		return overdue[i].Occurrence.Date.Before(overdue[j].Occurrence.Date)
+	})
+	return
+}
+
+func paymentKey(expenseId string, date time.Time) string {
+	return expenseId + "|" + dateOnly(date).Format(time.DateOnly)
+}
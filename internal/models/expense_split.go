@@ -0,0 +1,155 @@
+package models
+
+import (
+	"errors"
+	"financial-backend/pkg/money"
+	"fmt"
+)
+
+type SplitType string
+
+const (
+	// SplitEqual divide a despesa igualmente entre os membros
+	SplitEqual SplitType = "equal"
+	// SplitPercentage divide a despesa pelo percentual de cada membro
+	SplitPercentage SplitType = "percentage"
+	// SplitExact usa o valor informado para cada membro
+	SplitExact SplitType = "exact"
+)
+
+// ExpenseShare é a parte de uma despesa que cabe a um membro
+type ExpenseShare struct {
+	MemberId   string
+	Amount     money.Money
+	Percentage *float64
+}
+
+// ExpenseSplit indica quem pagou a despesa e como ela é dividida entre os membros
+type ExpenseSplit struct {
+	PaidBy string
+	Type   SplitType
+	Shares []ExpenseShare
+}
+
+// NewExpenseSplit valida a divisão e calcula o valor de cada parte sobre o total da despesa
+func NewExpenseSplit(total money.Money, paidBy, splitType string, shares []ExpenseShare) (*ExpenseSplit, error) {
+	if paidBy == "" {
+		return nil, errors.New("é necessário informar quem pagou a despesa")
+	}
+	if len(shares) == 0 {
+		return nil, errors.New("a divisão precisa de ao menos um membro")
+	}
+
+	seen := map[string]bool{}
+	for _, share := range shares {
+		if share.MemberId == "" {
+			return nil, errors.New("toda parte da divisão precisa de um membro")
+		}
+		if seen[share.MemberId] {
+			return nil, fmt.Errorf("membro %s aparece mais de uma vez na divisão", share.MemberId)
+		}
+		seen[share.MemberId] = true
+	}
+
+	split := &ExpenseSplit{PaidBy: paidBy, Type: SplitType(splitType)}
+	switch split.Type {
+	case SplitEqual:
+		split.Shares = equalShares(total, shares)
+	case SplitPercentage:
+		resolved, err := percentageShares(total, shares)
+		if err != nil {
+			return nil, err
+		}
+		split.Shares = resolved
+	case SplitExact:
+		var sum money.Money
+		for _, share := range shares {
+			if share.Amount.IsNegative() {
+				return nil, errors.New("valor da parte não pode ser negativo")
+			}
+			sum = sum.Add(share.Amount)
+		}
+		if sum != total {
+			return nil, fmt.Errorf("a soma das partes (%s) deve ser igual ao valor da despesa (%s)", sum, total)
+		}
+		split.Shares = shares
+	default:
+		return nil, fmt.Errorf("tipo de divisão inválido: %s", splitType)
+	}
+
+	return split, nil
+}
+
+// equalShares divide o total igualmente; os centavos que sobram ficam com os primeiros membros
+func equalShares(total money.Money, shares []ExpenseShare) []ExpenseShare {
+	count := int64(len(shares))
+	base := total.Cents() / count
+	remainder := total.Cents() % count
+
+	resolved := make([]ExpenseShare, len(shares))
+	for i, share := range shares {
+		cents := base
+		if int64(i) < remainder {
+			cents++
+		}
+		resolved[i] = ExpenseShare{MemberId: share.MemberId, Amount: money.FromCents(cents)}
+	}
+	return resolved
+}
+
+// percentageShares calcula cada parte pelo percentual; a diferença de arredondamento fica com a última parte
+func percentageShares(total money.Money, shares []ExpenseShare) ([]ExpenseShare, error) {
+	resolved := make([]ExpenseShare, len(shares))
+	var percentage float64
+	var sum money.Money
+
+	for i, share := range shares {
+		if share.Percentage == nil || *share.Percentage < 0 {
+			return nil, errors.New("divisão por percentual exige o percentual de cada membro")
+		}
+		percentage += *share.Percentage
+		resolved[i] = ExpenseShare{MemberId: share.MemberId, Amount: total.Percent(*share.Percentage), Percentage: share.Percentage}
+		sum = sum.Add(resolved[i].Amount)
+	}
+
+	if percentage < 99.99 || percentage > 100.01 {
+		return nil, fmt.Errorf("a soma dos percentuais (%.2f) deve ser 100", percentage)
+	}
+
+	last := len(resolved) - 1
+	resolved[last].Amount = resolved[last].Amount.Add(total.Sub(sum))
+	return resolved, nil
+}
+
+// SharesOf distribui amount entre os membros na mesma proporção das partes sobre total.
+// Usado para ocorrências cujo valor difere do total da despesa, como parcelas.
+func (s *ExpenseSplit) SharesOf(amount, total money.Money) []ExpenseShare {
+	if amount == total || total.IsZero() {
+		return s.Shares
+	}
+
+	scaled := make([]ExpenseShare, len(s.Shares))
+	var sum money.Money
+	for i, share := range s.Shares {
+		scaled[i] = ExpenseShare{
+			MemberId: share.MemberId,
+			Amount:   money.FromFloat(share.Amount.Float64() * amount.Float64() / total.Float64()),
+		}
+		sum = sum.Add(scaled[i].Amount)
+	}
+
+	last := len(scaled) - 1
+	scaled[last].Amount = scaled[last].Amount.Add(amount.Sub(sum))
+	return scaled
+}
+
+// MemberIds retorna o pagador e os membros da divisão, sem repetição
+func (s *ExpenseSplit) MemberIds() []string {
+	ids := []string{s.PaidBy}
+	for _, share := range s.Shares {
+		if share.MemberId != s.PaidBy {
+			ids = append(ids, share.MemberId)
+		}
+	}
+	return ids
+}
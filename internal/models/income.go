@@ -2,6 +2,7 @@ package models
 
 import (
 	"errors"
+	"financial-backend/pkg/money"
 	"strings"
 	"time"
 )
@@ -16,40 +17,59 @@ const (
 type Income interface {
 	ID() string
 	Description() string
-	Amount() float64
+	Amount() money.Money
 	Type() IncomeType
 	DueDay() int
+	Rrule() *string
+	CategoryId() *string
+	Tags() []string
 	StartDate() time.Time
 	EndDate() *time.Time
 	CreatedAt() time.Time
 	UpdatedAt() time.Time
+	DeletedAt() *time.Time
+
+	// WithDeletedAt marca a receita como excluída; nil indica uma receita ativa
+	WithDeletedAt(deletedAt *time.Time) Income
 }
 
 type income struct {
 	id          string
 	description string
-	amount      float64
+	amount      money.Money
 	incomeType  IncomeType
 	dueDay      int
+	rrule       *string
+	categoryId  *string
+	tags        []string
 	startDate   time.Time
 	endDate     *time.Time
 	createdAt   time.Time
 	updatedAt   time.Time
+	deletedAt   *time.Time
 }
 
-func NewIncome(id, description string, amount float64, incomeType IncomeType, dueDay int, startDate time.Time, endDate *time.Time) (Income, error) {
+func NewIncome(id, description string, amount money.Money, incomeType IncomeType, dueDay int, rrule, categoryId *string, startDate time.Time, endDate *time.Time, tags []string) (Income, error) {
 	now := time.Now()
 
 	if incomeType == IncomeTypeVariable && endDate == nil {
 		return nil, errors.New("receita váriavel é obrigatório data final")
 	}
 
+	// receitas não têm recorrência própria: quando informada, a rrule sempre substitui a agenda mensal
+	if err := validateRRule(rrule, startDate, true); err != nil {
+		return nil, err
+	}
+
 	return &income{
 		id:          id,
 		description: strings.ToUpper(description),
 		amount:      amount,
 		incomeType:  incomeType,
 		dueDay:      dueDay,
+		rrule:       rrule,
+		categoryId:  categoryId,
+		tags:        NormalizeTags(tags),
 		startDate:   startDate,
 		endDate:     endDate,
 		createdAt:   now,
@@ -65,7 +85,7 @@ func (i *income) Description() string {
 	return i.description
 }
 
-func (i *income) Amount() float64 {
+func (i *income) Amount() money.Money {
 	return i.amount
 }
 
@@ -77,6 +97,18 @@ func (i *income) DueDay() int {
 	return i.dueDay
 }
 
+func (i *income) Rrule() *string {
+	return i.rrule
+}
+
+func (i *income) CategoryId() *string {
+	return i.categoryId
+}
+
+func (i *income) Tags() []string {
+	return i.tags
+}
+
 func (i *income) StartDate() time.Time {
 	return i.startDate
 }
@@ -92,3 +124,12 @@ func (i *income) CreatedAt() time.Time {
 func (i *income) UpdatedAt() time.Time {
 	return i.updatedAt
 }
+
+func (i *income) DeletedAt() *time.Time {
+	return i.deletedAt
+}
+
+func (i *income) WithDeletedAt(deletedAt *time.Time) Income {
+	i.deletedAt = deletedAt
+	return i
+}
@@ -0,0 +1,52 @@
+package models
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+type Member interface {
+	ID() string
+	Name() string
+	CreatedAt() time.Time
+	UpdatedAt() time.Time
+}
+
+type member struct {
+	id        string
+	name      string
+	createdAt time.Time
+	updatedAt time.Time
+}
+
+func NewMember(id, name string) (Member, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, errors.New("nome do membro é obrigatório")
+	}
+
+	now := time.Now()
+	return &member{
+		id:        id,
+		name:      name,
+		createdAt: now,
+		updatedAt: now,
+	}, nil
+}
+
+func (m *member) ID() string {
+	return m.id
+}
+
+func (m *member) Name() string {
+	return m.name
+}
+
+func (m *member) CreatedAt() time.Time {
+	return m.createdAt
+}
+
+func (m *member) UpdatedAt() time.Time {
+	return m.updatedAt
+}
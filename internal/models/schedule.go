@@ -0,0 +1,248 @@
+package models
+
+import (
+	"financial-backend/pkg/money"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/teambition/rrule-go"
+)
+
+type ScheduleFrequency string
+
+const (
+	ScheduleOnce    ScheduleFrequency = "once"
+	ScheduleDaily   ScheduleFrequency = "daily"
+	ScheduleWeekly  ScheduleFrequency = "weekly"
+	ScheduleMonthly ScheduleFrequency = "monthly"
+	ScheduleRRule   ScheduleFrequency = "rrule"
+)
+
+// lastBusinessDayRule é a RRULE do último dia útil (segunda a sexta) de cada mês
+const lastBusinessDayRule = "FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1"
+
+// Occurrence representa uma ocorrência concreta de uma despesa ou receita
+type Occurrence struct {
+	Number int
+	Date   time.Time
+	Amount money.Money
+}
+
+// Schedule calcula as ocorrências de uma despesa ou receita.
+//
+// Agendas mensais (recorrência mensal, parcelas e despesas avulsas) caem no DueDay de cada mês,
+// limitado ao último dia do mês, a partir do mês da StartDate. Agendas diárias e semanais
+// contam a partir da própria StartDate. Agendas com RRULE seguem a regra com DTSTART na StartDate.
+// Parcelas terminam pela quantidade, as demais pela EndDate.
+type Schedule interface {
+	Frequency() ScheduleFrequency
+	Count() *int
+	Occurrences(from, to time.Time) []Occurrence
+}
+
+type schedule struct {
+	frequency ScheduleFrequency
+	interval  int
+	rule      *rrule.RRule
+	startDate time.Time
+	endDate   *time.Time
+	dueDay    int
+	count     *int
+	amount    money.Money
+}
+
+func newSchedule(frequency ScheduleFrequency, interval int, startDate time.Time, endDate *time.Time, dueDay int, count *int, amount money.Money) *schedule {
+	return &schedule{
+		frequency: frequency,
+		interval:  interval,
+		startDate: startDate,
+		endDate:   endDate,
+		dueDay:    dueDay,
+		count:     count,
+		amount:    amount,
+	}
+}
+
+// NewExpenseSchedule monta a agenda de uma despesa a partir do tipo, recorrência, RRULE e parcelas
+func NewExpenseSchedule(expense Expense) Schedule {
+	newExpenseSchedule := func(frequency ScheduleFrequency, interval int) *schedule {
+		return newSchedule(frequency, interval, expense.StartDate(), expense.EndDate(), expense.DueDay(), expense.Installments(), expense.Amount())
+	}
+
+	if expense.Installments() != nil {
+		return newExpenseSchedule(ScheduleMonthly, 1)
+	}
+
+	if expense.Type() != ExpenseTypeRecurring || expense.Recurrency() == nil {
+		return newExpenseSchedule(ScheduleOnce, 1)
+	}
+
+	switch *expense.Recurrency() {
+	case ExpenseRecurrencyDaily:
+		return newExpenseSchedule(ScheduleDaily, 1)
+	case ExpenseRecurrencyWeekly:
+		return newExpenseSchedule(ScheduleWeekly, 1)
+	case ExpenseRecurrencyBiweekly:
+		return newExpenseSchedule(ScheduleWeekly, 2)
+	case ExpenseRecurrencyBimonthly:
+		return newExpenseSchedule(ScheduleMonthly, 2)
+	case ExpenseRecurrencyQuarterly:
+		return newExpenseSchedule(ScheduleMonthly, 3)
+	case ExpenseRecurrencyYearly:
+		return newExpenseSchedule(ScheduleMonthly, 12)
+	case ExpenseRecurrencyLastBusinessDay:
+		return newExpenseSchedule(ScheduleMonthly, 1).withRule(lastBusinessDayRule)
+	case ExpenseRecurrencyCustom:
+		if expense.Rrule() != nil {
+			return newExpenseSchedule(ScheduleMonthly, 1).withRule(*expense.Rrule())
+		}
+	}
+
+	return newExpenseSchedule(ScheduleMonthly, 1)
+}
+
+// NewIncomeSchedule monta a agenda de uma receita: mensal no DueDay ou pela RRULE, quando informada
+func NewIncomeSchedule(income Income) Schedule {
+	s := newSchedule(ScheduleMonthly, 1, income.StartDate(), income.EndDate(), income.DueDay(), nil, income.Amount())
+	if income.Rrule() != nil {
+		return s.withRule(*income.Rrule())
+	}
+	return s
+}
+
+// ParseRRule interpreta uma RRULE (com ou sem o prefixo "RRULE:") usando startDate como DTSTART
+func ParseRRule(value string, startDate time.Time) (*rrule.RRule, error) {
+	option, err := rrule.StrToROption(strings.TrimPrefix(strings.TrimSpace(value), "RRULE:"))
+	if err != nil {
+		return nil, fmt.Errorf("rrule inválida: %v", err)
+	}
+
+	option.Dtstart = dateOnly(startDate)
+
+	rule, err := rrule.NewRRule(*option)
+	if err != nil {
+		return nil, fmt.Errorf("rrule inválida: %v", err)
+	}
+	return rule, nil
+}
+
+// validateRRule verifica a rrule de uma despesa ou receita. applies indica se a agenda vai de fato usar a regra;
+// uma regra que seria ignorada é recusada em vez de ser gravada sem efeito.
+func validateRRule(value *string, startDate time.Time, applies bool) error {
+	if value == nil {
+		return nil
+	}
+	if !applies {
+		return fmt.Errorf("rrule só pode ser informada com a recorrência %s", ExpenseRecurrencyCustom)
+	}
+	_, err := ParseRRule(*value, startDate)
+	return err
+}
+
+// withRule troca a agenda para a RRULE informada. As regras são validadas ao criar a despesa ou a receita,
+// então uma regra inválida aqui só vem de dados antigos e mantém a agenda original
+func (s *schedule) withRule(value string) *schedule {
+	rule, err := ParseRRule(value, s.startDate)
+	if err != nil {
+		return s
+	}
+	s.frequency = ScheduleRRule
+	s.rule = rule
+	return s
+}
+
+func (s *schedule) Frequency() ScheduleFrequency {
+	return s.frequency
+}
+
+func (s *schedule) Count() *int {
+	return s.count
+}
+
+// Occurrences retorna as ocorrências entre from e to, ambos inclusivos
+func (s *schedule) Occurrences(from, to time.Time) (occurrences []Occurrence) {
+	from, to = dateOnly(from), dateOnly(to)
+
+	var endDate *time.Time
+	if s.endDate != nil && s.count == nil {
+		end := dateOnly(*s.endDate)
+		endDate = &end
+	}
+
+	var next rrule.Next
+	if s.rule != nil {
+		next = s.rule.Iterator()
+	}
+
+	for number := 1; s.count == nil || number <= *s.count; number++ {
+		var date time.Time
+		if next != nil {
+			value, ok := next()
+			if !ok {
+				break
+			}
+			date = dateOnly(value)
+		} else {
+			date = s.occurrenceDate(number - 1)
+		}
+
+		if date.After(to) || (endDate != nil && date.After(*endDate)) {
+			break
+		}
+
+		if !date.Before(from) {
+			occurrences = append(occurrences, Occurrence{
+				Number: number,
+				Date:   date,
+				Amount: s.amount,
+			})
+		}
+
+		if s.frequency == ScheduleOnce {
+			break
+		}
+	}
+
+	return
+}
+
+func (s *schedule) occurrenceDate(index int) time.Time {
+	start := dateOnly(s.startDate)
+
+	switch s.frequency {
+	case ScheduleDaily:
+		return start.AddDate(0, 0, index*s.interval)
+	case ScheduleWeekly:
+		return start.AddDate(0, 0, 7*index*s.interval)
+	default:
+		month := time.Date(start.Year(), start.Month()+time.Month(index*s.interval), 1, 0, 0, 0, 0, time.UTC)
+		return DayInMonth(month.Year(), month.Month(), s.day())
+	}
+}
+
+func (s *schedule) day() int {
+	if s.dueDay < 1 || s.dueDay > 31 {
+		return s.startDate.Day()
+	}
+	return s.dueDay
+}
+
+// DayInMonth retorna a data do dia informado no mês, limitada ao último dia do mês
+func DayInMonth(year int, month time.Month, day int) time.Time {
+	lastDay := time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+	if day > lastDay {
+		day = lastDay
+	}
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// MonthRange retorna o primeiro e o último dia do mês
+func MonthRange(year int, month time.Month) (time.Time, time.Time) {
+	first := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	return first, first.AddDate(0, 1, -1)
+}
+
+func dateOnly(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,171 @@
+package models
+
+import (
+	"errors"
+	"financial-backend/pkg/money"
+	"sort"
+	"time"
+)
+
+// Settlement é um reembolso de um membro para outro, que abate o saldo entre eles
+type Settlement interface {
+	ID() string
+	FromMemberId() string
+	ToMemberId() string
+	Amount() money.Money
+	SettledAt() time.Time
+}
+
+type settlement struct {
+	id           string
+	fromMemberId string
+	toMemberId   string
+	amount       money.Money
+	settledAt    time.Time
+}
+
+func NewSettlement(id, fromMemberId, toMemberId string, amount money.Money, settledAt time.Time) (Settlement, error) {
+	if fromMemberId == toMemberId {
+		return nil, errors.New("reembolso precisa envolver dois membros diferentes")
+	}
+	if !amount.IsPositive() {
+		return nil, errors.New("valor do reembolso deve ser maior que zero")
+	}
+
+	return &settlement{
+		id:           id,
+		fromMemberId: fromMemberId,
+		toMemberId:   toMemberId,
+		amount:       amount,
+		settledAt:    settledAt,
+	}, nil
+}
+
+func (s *settlement) ID() string {
+	return s.id
+}
+
+func (s *settlement) FromMemberId() string {
+	return s.fromMemberId
+}
+
+func (s *settlement) ToMemberId() string {
+	return s.toMemberId
+}
+
+func (s *settlement) Amount() money.Money {
+	return s.amount
+}
+
+func (s *settlement) SettledAt() time.Time {
+	return s.settledAt
+}
+
+// MemberBalance é a posição de um membro nas despesas compartilhadas.
+// Balance positivo indica que o membro tem a receber; negativo, que tem a pagar.
+type MemberBalance struct {
+	MemberId string
+	Paid     money.Money
+	Share    money.Money
+	Balance  money.Money
+}
+
+// Transfer é um pagamento sugerido para quitar os saldos entre membros
+type Transfer struct {
+	FromMemberId string
+	ToMemberId   string
+	Amount       money.Money
+}
+
+// ComputeBalances soma o que cada membro pagou e o que lhe cabe nas ocorrências das despesas
+// compartilhadas até until, abatendo os reembolsos já registrados
+func ComputeBalances(expenses []Expense, settlements []Settlement, until time.Time) []MemberBalance {
+	balances := map[string]*MemberBalance{}
+	balanceOf := func(memberId string) *MemberBalance {
+		if balances[memberId] == nil {
+			balances[memberId] = &MemberBalance{MemberId: memberId}
+		}
+		return balances[memberId]
+	}
+
+	for _, expense := range expenses {
+		split := expense.Split()
+		if split == nil {
+			continue
+		}
+
+		for _, occurrence := range NewExpenseSchedule(expense).Occurrences(expense.StartDate(), until) {
+			payer := balanceOf(split.PaidBy)
+			payer.Paid = payer.Paid.Add(occurrence.Amount)
+
+			for _, share := range split.SharesOf(occurrence.Amount, expense.Amount()) {
+				member := balanceOf(share.MemberId)
+				member.Share = member.Share.Add(share.Amount)
+			}
+		}
+	}
+
+	for _, settlement := range settlements {
+		if settlement.SettledAt().After(until) {
+			continue
+		}
+		from := balanceOf(settlement.FromMemberId())
+		from.Paid = from.Paid.Add(settlement.Amount())
+		to := balanceOf(settlement.ToMemberId())
+		to.Paid = to.Paid.Sub(settlement.Amount())
+	}
+
+	result := make([]MemberBalance, 0, len(balances))
+	for _, balance := range balances {
+		balance.Balance = balance.Paid.Sub(balance.Share)
+		result = append(result, *balance)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].MemberId < result[j].MemberId
+	})
+	return result
+}
+
+// NetTransfers calcula o menor conjunto de transferências que zera os saldos,
+// casando sempre o maior devedor com o maior credor
+func NetTransfers(balances []MemberBalance) []Transfer {
+	var debtors, creditors []MemberBalance
+	for _, balance := range balances {
+		if balance.Balance.IsNegative() {
+			debtors = append(debtors, MemberBalance{MemberId: balance.MemberId, Balance: balance.Balance.Abs()})
+		} else if balance.Balance.IsPositive() {
+			creditors = append(creditors, balance)
+		}
+	}
+
+	byBalance := func(list []MemberBalance) func(i, j int) bool {
+		return func(i, j int) bool {
+			if list[i].Balance == list[j].Balance {
+				return list[i].MemberId < list[j].MemberId
+			}
+			return list[i].Balance > list[j].Balance
+		}
+	}
+	sort.Slice(debtors, byBalance(debtors))
+	sort.Slice(creditors, byBalance(creditors))
+
+	var transfers []Transfer
+	for d, c := 0, 0; d < len(debtors) && c < len(creditors); {
+		amount := min(debtors[d].Balance, creditors[c].Balance)
+		transfers = append(transfers, Transfer{
+			FromMemberId: debtors[d].MemberId,
+			ToMemberId:   creditors[c].MemberId,
+			Amount:       amount,
+		})
+
+		debtors[d].Balance = debtors[d].Balance.Sub(amount)
+		creditors[c].Balance = creditors[c].Balance.Sub(amount)
+		if debtors[d].Balance.IsZero() {
+			d++
+		}
+		if creditors[c].Balance.IsZero() {
+			c++
+		}
+	}
+	return transfers
+}
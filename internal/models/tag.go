@@ -0,0 +1,32 @@
+package models
+
+import (
+	"sort"
+	"strings"
+)
+
+// NormalizeTags padroniza as tags em minúsculas, sem espaços nas pontas, sem vazias e sem repetição
+func NormalizeTags(tags []string) []string {
+	if tags == nil {
+		return nil
+	}
+
+	seen := map[string]bool{}
+	normalized := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = NormalizeTag(tag)
+		if tag == "" || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		normalized = append(normalized, tag)
+	}
+
+	sort.Strings(normalized)
+	return normalized
+}
+
+// NormalizeTag padroniza uma tag em minúsculas e sem espaços nas pontas
+func NormalizeTag(tag string) string {
+	return strings.ToLower(strings.TrimSpace(tag))
+}
@@ -0,0 +1,14 @@
+package attachment
+
+import (
+	"context"
+
+	"financial-backend/internal/entities"
+)
+
+type Repository interface {
+	Create(ctx context.Context, attachment *entities.Attachment) error
+	Get(ctx context.Context, ownerType, ownerId, id string) (*entities.Attachment, error)
+	ListByOwner(ctx context.Context, ownerType, ownerId string) ([]entities.Attachment, error)
+	Delete(ctx context.Context, id string) error
+}
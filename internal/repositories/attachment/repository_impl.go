@@ -0,0 +1,45 @@
+package attachment
+
+import (
+	"context"
+	"fmt"
+
+	"financial-backend/internal/entities"
+
+	"gorm.io/gorm"
+)
+
+type repository struct {
+	db *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) Repository {
+	return &repository{db: db}
+}
+
+func (r *repository) Create(ctx context.Context, attachment *entities.Attachment) error {
+	return r.db.WithContext(ctx).Create(attachment).Error
+}
+
+func (r *repository) Get(ctx context.Context, ownerType, ownerId, id string) (*entities.Attachment, error) {
+	var attachment entities.Attachment
+	if err := r.db.WithContext(ctx).
+		First(&attachment, "id = ? AND owner_type = ? AND owner_id = ?", id, ownerType, ownerId).Error; err != nil {
+		return nil, fmt.Errorf("erro ao buscar anexo: %v", err)
+	}
+	return &attachment, nil
+}
+
+func (r *repository) ListByOwner(ctx context.Context, ownerType, ownerId string) (attachments []entities.Attachment, err error) {
+	if err := r.db.WithContext(ctx).
+		Where("owner_type = ? AND owner_id = ?", ownerType, ownerId).
+		Order("created_at").
+		Find(&attachments).Error; err != nil {
+		return nil, fmt.Errorf("erro ao listar anexos: %v", err)
+	}
+	return
+}
+
+func (r *repository) Delete(ctx context.Context, id string) error {
+	return r.db.WithContext(ctx).Where("id = ?", id).Delete(&entities.Attachment{}).Error
+}
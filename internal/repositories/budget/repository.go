@@ -12,6 +12,11 @@ type Repository interface {
 	Update(ctx context.Context, budget *entities.Budget) error
 	Delete(ctx context.Context, id string) error
 	Get(ctx context.Context, id string) (*entities.Budget, error)
-	List(ctx context.Context, status string, description string, page models.PageRequest) ([]entities.Budget, int64, error)
+	List(ctx context.Context, status string, description string, includeDeleted bool, page models.PageRequest) ([]entities.Budget, int64, error)
+	Restore(ctx context.Context, id string) error
 	GetBudgetsWithoutMovement(ctx context.Context) ([]entities.Budget, error)
+	UpdateAmount(ctx context.Context, budget *entities.Budget, version *entities.BudgetAmountVersion, movement *entities.BudgetMovement) error
+	Descendants(ctx context.Context, id string) ([]string, error)
+	ListDescendants(ctx context.Context, ids []string, includeDeleted bool) ([]entities.Budget, error)
+	CountChildren(ctx context.Context, id string) (int64, error)
 }
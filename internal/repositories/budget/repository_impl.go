@@ -3,6 +3,7 @@ package budget
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"financial-backend/internal/entities"
 	"financial-backend/internal/models"
@@ -30,19 +31,96 @@ func (r *repository) Delete(ctx context.Context, id string) error {
 	return r.db.WithContext(ctx).Where("id = ?", id).Delete(&entities.Budget{}).Error
 }
 
+// Descendants retorna os ids do orçamento e de todos os seus descendentes
+func (r *repository) Descendants(ctx context.Context, id string) (ids []string, err error) {
+	if err := r.db.WithContext(ctx).Raw(SubtreeQuery, []string{id}).Scan(&ids).Error; err != nil {
+		return nil, fmt.Errorf("erro ao buscar orçamentos filhos: %v", err)
+	}
+	return
+}
+
+// ListDescendants retorna todos os descendentes dos orçamentos informados, sem incluí-los
+func (r *repository) ListDescendants(ctx context.Context, ids []string, includeDeleted bool) (budgets []entities.Budget, err error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	query := r.db.WithContext(ctx)
+	if includeDeleted {
+		query = query.Unscoped()
+	}
+
+	if err := query.
+		Where("id IN (?) AND id NOT IN ?", gorm.Expr(SubtreeQuery, ids), ids).
+		Preload("AmountVersions").
+		Order("description").
+		Find(&budgets).Error; err != nil {
+		return nil, fmt.Errorf("erro ao listar orçamentos filhos: %v", err)
+	}
+	return
+}
+
+func (r *repository) CountChildren(ctx context.Context, id string) (count int64, err error) {
+	if err := r.db.WithContext(ctx).Model(&entities.Budget{}).Where("parent_id = ?", id).Count(&count).Error; err != nil {
+		return 0, fmt.Errorf("erro ao contar orçamentos filhos: %v", err)
+	}
+	return
+}
+
+// UpdateAmount grava o orçamento com um novo valor numa única transação: a versão é registrada (as anteriores são
+// mantidas como histórico) junto com o lançamento da diferença no período, quando houver
+func (r *repository) UpdateAmount(ctx context.Context, budget *entities.Budget, version *entities.BudgetAmountVersion, movement *entities.BudgetMovement) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(budget).Error; err != nil {
+			return err
+		}
+		if err := tx.Create(version).Error; err != nil {
+			return fmt.Errorf("erro ao registrar valor do orçamento: %v", err)
+		}
+		if movement != nil {
+			if err := tx.Create(movement).Error; err != nil {
+				return fmt.Errorf("erro ao lançar diferença do valor do orçamento: %v", err)
+			}
+		}
+		return nil
+	})
+}
+
+// Restore desfaz a exclusão lógica do orçamento
+func (r *repository) Restore(ctx context.Context, id string) error {
+	result := r.db.WithContext(ctx).Unscoped().
+		Model(&entities.Budget{}).
+		Where("id = ? AND deleted_at IS NOT NULL", id).
+		Update("deleted_at", nil)
+	if result.Error != nil {
+		return fmt.Errorf("erro ao restaurar orçamento: %v", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("orçamento %s não encontrado entre os excluídos", id)
+	}
+	return nil
+}
+
 func (r *repository) Get(ctx context.Context, id string) (*entities.Budget, error) {
 	var budget entities.Budget
-	if err := r.db.WithContext(ctx).First(&budget, "id = ?", id).Error; err != nil {
+	if err := r.db.WithContext(ctx).Preload("AmountVersions").First(&budget, "id = ?", id).Error; err != nil {
 		return nil, fmt.Errorf("erro ao buscar orçamento: %v", err)
 	}
 	return &budget, nil
 }
 
-func (r *repository) List(ctx context.Context, status string, description string, page models.PageRequest) (budgets []entities.Budget, count int64, err error) {
+func (r *repository) List(ctx context.Context, status string, description string, includeDeleted bool, page models.PageRequest) (budgets []entities.Budget, count int64, err error) {
 	query := r.db.WithContext(ctx)
 
+	if includeDeleted {
+		query = query.Unscoped()
+	}
+
+	// sem busca por descrição, a listagem traz só os orçamentos raiz; os filhos vêm aninhados
 	if description != "" {
 		query = query.Where("description LIKE ?", "%"+description+"%")
+	} else {
+		query = query.Where("parent_id is null")
 	}
 
 	switch status {
@@ -52,7 +130,7 @@ func (r *repository) List(ctx context.Context, status string, description string
 		query = query.Where("end_date is not null and end_date < CURRENT_DATE")
 	default:
 	}
-	if err = query.Offset(page.Offset()).Limit(int(page.Limit)).Find(&budgets).Error; err != nil {
+	if err = query.Preload("AmountVersions").Offset(page.Offset()).Limit(int(page.Limit)).Find(&budgets).Error; err != nil {
 		return nil, 0, fmt.Errorf("erro ao listar orçamentos: %v", err)
 	}
 
@@ -63,17 +141,42 @@ func (r *repository) List(ctx context.Context, status string, description string
 	return budgets, count, nil
 }
 
+// SubtreeQuery seleciona o id do orçamento informado e de todos os seus descendentes
+const SubtreeQuery = `WITH RECURSIVE budget_tree AS (
+	SELECT id FROM budgets WHERE id IN ?
+	UNION ALL
+	SELECT b.id FROM budgets b JOIN budget_tree t ON b.parent_id = t.id
+) SELECT id FROM budget_tree`
+
+// AmountInForce é o valor do orçamento b em vigor na data informada: a versão de valor mais recente
+// que já começou a valer ou, sem versões, o valor com que o orçamento foi criado
+const AmountInForce = `COALESCE((SELECT v.amount
+	FROM budget_amount_versions v
+	WHERE v.budget_id = b.id AND v.effective_from <= CAST(? AS date)
+	ORDER BY v.effective_from DESC, v.created_at DESC
+	LIMIT 1), b.amount)`
+
+// PeriodUnit traduz o período do orçamento b para a unidade do date_trunc do postgres
+const PeriodUnit = `CASE b.period WHEN 'weekly' THEN 'week' WHEN 'quarterly' THEN 'quarter' WHEN 'yearly' THEN 'year' ELSE 'month' END`
+
+// InPeriodCondition filtra as movimentações bm que caem no período do orçamento b que contém a data informada.
+// Movimentações são registradas por mês; nos orçamentos semanais a data da movimentação decide a semana
+// (a de criação nas movimentações antigas, sem data).
+const InPeriodCondition = `date_trunc(` + PeriodUnit + `, CASE WHEN b.period = 'weekly' THEN COALESCE(CAST(bm.date AS timestamptz), bm.created_at) ELSE make_date(bm.year, bm.month, 1) END)
+	= date_trunc(` + PeriodUnit + `, CAST(? AS timestamptz))`
+
+// GetBudgetsWithoutMovement retorna os orçamentos vigentes que ainda não tiveram a movimentação de início no período atual
 func (r *repository) GetBudgetsWithoutMovement(ctx context.Context) (reponses []entities.Budget, err error) {
 	query := `select *
 from budgets b
 where (end_date is null or end_date >= current_date)
+  and b.deleted_at is null
   and not exists(select 1
                  from budget_movements bm
-                 where bm.month = cast(to_char(now() :: date, 'MM') as numeric)
-                   and bm.year = cast(to_char(now() :: date, 'YYYY') as numeric)
+                 where ` + InPeriodCondition + `
                    and bm.type = 'start'
                    and bm.budget_id = b.id)`
-	if err := r.db.WithContext(ctx).Raw(query).Find(&reponses).Error; err != nil {
+	if err := r.db.WithContext(ctx).Raw(query, time.Now()).Preload("AmountVersions").Find(&reponses).Error; err != nil {
 		return []entities.Budget{}, err
 	}
 	return
@@ -0,0 +1,15 @@
+package budgetalert
+
+import (
+	"context"
+
+	"financial-backend/internal/entities"
+)
+
+type Repository interface {
+	CreateNotifier(ctx context.Context, notifier *entities.BudgetNotifier) error
+	DeleteNotifier(ctx context.Context, budgetId, id string) error
+	ListNotifiers(ctx context.Context, budgetId string) ([]entities.BudgetNotifier, error)
+	CreateAlert(ctx context.Context, alert *entities.BudgetAlert) (bool, error)
+	ListAlerts(ctx context.Context, budgetId string) ([]entities.BudgetAlert, error)
+}
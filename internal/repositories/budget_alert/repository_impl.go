@@ -0,0 +1,59 @@
+package budgetalert
+
+import (
+	"context"
+	"fmt"
+
+	"financial-backend/internal/entities"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type repository struct {
+	db *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) Repository {
+	return &repository{db: db}
+}
+
+func (r *repository) CreateNotifier(ctx context.Context, notifier *entities.BudgetNotifier) error {
+	return r.db.WithContext(ctx).Create(notifier).Error
+}
+
+func (r *repository) DeleteNotifier(ctx context.Context, budgetId, id string) error {
+	result := r.db.WithContext(ctx).Where("id = ? AND budget_id = ?", id, budgetId).Delete(&entities.BudgetNotifier{})
+	if result.Error != nil {
+		return fmt.Errorf("erro ao excluir notificador: %v", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("notificador %s não encontrado no orçamento", id)
+	}
+	return nil
+}
+
+func (r *repository) ListNotifiers(ctx context.Context, budgetId string) (notifiers []entities.BudgetNotifier, err error) {
+	if err := r.db.WithContext(ctx).Where("budget_id = ?", budgetId).Order("created_at").Find(&notifiers).Error; err != nil {
+		return nil, fmt.Errorf("erro ao listar notificadores: %v", err)
+	}
+	return
+}
+
+// CreateAlert registra o alerta e informa se ele é novo; um alerta repetido para o mesmo limite e período é ignorado
+func (r *repository) CreateAlert(ctx context.Context, alert *entities.BudgetAlert) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(alert)
+	if result.Error != nil {
+		return false, fmt.Errorf("erro ao registrar alerta: %v", result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (r *repository) ListAlerts(ctx context.Context, budgetId string) (alerts []entities.BudgetAlert, err error) {
+	if err := r.db.WithContext(ctx).Where("budget_id = ?", budgetId).Order("created_at DESC").Find(&alerts).Error; err != nil {
+		return nil, fmt.Errorf("erro ao listar alertas: %v", err)
+	}
+	return
+}
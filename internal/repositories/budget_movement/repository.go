@@ -5,12 +5,24 @@ import (
 	"financial-backend/internal/entities"
 	"financial-backend/internal/models"
 	"financial-backend/internal/views"
+	"financial-backend/pkg/money"
+	"time"
 )
 
 type Repository interface {
 	CreateAll(ctx context.Context, budgetMovements []entities.BudgetMovement) error
 	Create(ctx context.Context, budgetMovement entities.BudgetMovement) error
-	List(ctx context.Context, budgetId, movementType, origin string, month, year int, page models.PageRequest) ([]entities.BudgetMovement, int64, error)
+	CreateAllWithinBalance(ctx context.Context, budgetId string, at time.Time, amount money.Money, budgetMovements []entities.BudgetMovement) (money.Money, bool, error)
+	List(ctx context.Context, budgetId, movementType, origin string, month, year int, tag string, page models.PageRequest) ([]entities.BudgetMovement, int64, error)
 	GetById(ctx context.Context, id string) (*entities.BudgetMovement, error)
+	ListByOrigin(ctx context.Context, origin, movementType string, fromMonth, fromYear int) ([]entities.BudgetMovement, error)
+	DeleteByOrigin(ctx context.Context, origin, movementType string, fromMonth, fromYear int) error
+	DeleteByInstallment(ctx context.Context, installmentId string) error
+	MoveByInstallment(ctx context.Context, installmentId string, dueDate time.Time) error
+	InstallmentExpensesWithoutLink(ctx context.Context) ([]string, error)
+	ListInPeriod(ctx context.Context, budgetId string, at time.Time) ([]entities.BudgetMovement, error)
+	ClosingBalance(ctx context.Context, budgetId string, at time.Time) (money.Money, bool, error)
+	PeriodUsage(ctx context.Context, budgetId string, at time.Time) (limit, spent money.Money, err error)
 	SummaryBudgetUsageByMonthYear(ctx context.Context, month, year int) (data []views.SummaryBudgetUtilization, err error)
+	RecurringUsageByMonthYear(ctx context.Context, month, year int) (map[string]money.Money, error)
 }
@@ -4,7 +4,9 @@ import (
 	"context"
 	"financial-backend/internal/entities"
 	"financial-backend/internal/models"
+	"financial-backend/internal/repositories/budget"
 	"financial-backend/internal/views"
+	"financial-backend/pkg/money"
 	"fmt"
 	"time"
 
@@ -20,8 +22,11 @@ func (r *repository) Create(ctx context.Context, budgetMovement entities.BudgetM
 	return r.db.WithContext(ctx).Create(budgetMovement).Error
 }
 
+// CreateAll grava as movimentações numa única transação, para que pares como as transferências nunca fiquem pela metade
 func (r *repository) CreateAll(ctx context.Context, budgetMovements []entities.BudgetMovement) error {
-	return r.db.WithContext(ctx).CreateInBatches(budgetMovements, 50).Error
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return tx.CreateInBatches(budgetMovements, 50).Error
+	})
 }
 
 // GetById implements Repository.
@@ -33,31 +38,118 @@ func (r *repository) GetById(ctx context.Context, id string) (budget *entities.B
 	return
 }
 
-// List implements Repository.
-func (r *repository) List(ctx context.Context, budgetId, movementType, origin string, month, year int, page models.PageRequest) (budgets []entities.BudgetMovement, count int64, err error) {
-	selectColumns := `SELECT 
+// ListByOrigin implements Repository.
+// Quando fromYear é informado, apenas as movimentações a partir de fromMonth/fromYear são retornadas.
+func (r *repository) ListByOrigin(ctx context.Context, origin, movementType string, fromMonth, fromYear int) (movements []entities.BudgetMovement, err error) {
+	if err := r.byOrigin(ctx, origin, movementType, fromMonth, fromYear).Preload("Budget", withDeleted).Preload("CounterpartBudget", withDeleted).Preload("Tags").Find(&movements).Error; err != nil {
+		return nil, fmt.Errorf("erro ao listar movimentações da origem %s: %w", origin, err)
+	}
+	return
+}
+
+// DeleteByOrigin implements Repository.
+// Quando fromYear é informado, apenas as movimentações a partir de fromMonth/fromYear são removidas.
+func (r *repository) DeleteByOrigin(ctx context.Context, origin, movementType string, fromMonth, fromYear int) error {
+	if err := r.deleteAll(ctx, r.byOrigin(ctx, origin, movementType, fromMonth, fromYear)); err != nil {
+		return fmt.Errorf("erro ao remover movimentações da origem %s: %w", origin, err)
+	}
+	return nil
+}
+
+// DeleteByInstallment implements Repository.
+// Remove as movimentações da parcela, uma por alocação da despesa.
+func (r *repository) DeleteByInstallment(ctx context.Context, installmentId string) error {
+	if err := r.deleteAll(ctx, r.byInstallment(ctx, installmentId)); err != nil {
+		return fmt.Errorf("erro ao remover movimentações da parcela %s: %w", installmentId, err)
+	}
+	return nil
+}
+
+// MoveByInstallment implements Repository.
+// Leva as movimentações da parcela para o novo vencimento.
+func (r *repository) MoveByInstallment(ctx context.Context, installmentId string, dueDate time.Time) error {
+	if err := r.byInstallment(ctx, installmentId).
+		Model(&entities.BudgetMovement{}).
+		Updates(map[string]interface{}{"month": int(dueDate.Month()), "year": dueDate.Year(), "date": dueDate}).Error; err != nil {
+		return fmt.Errorf("erro ao mover movimentações da parcela %s: %w", installmentId, err)
+	}
+	return nil
+}
+
+// InstallmentExpensesWithoutLink retorna as despesas parceladas com movimentações ainda sem a parcela ligada,
+// geradas antes de as movimentações guardarem a parcela
+func (r *repository) InstallmentExpensesWithoutLink(ctx context.Context) (ids []string, err error) {
+	if err := r.db.WithContext(ctx).
+		Table("budget_movements bm").
+		Joins("JOIN expenses e ON e.id = bm.origin AND e.installments IS NOT NULL AND e.deleted_at IS NULL").
+		Where("bm.type = ? AND bm.installment_id IS NULL", string(models.MovementExpense)).
+		Distinct().
+		Pluck("bm.origin", &ids).Error; err != nil {
+		return nil, fmt.Errorf("erro ao buscar movimentações de parcelas sem vínculo: %w", err)
+	}
+	return
+}
+
+// deleteAll remove as movimentações selecionadas por query junto com as suas tags
+func (r *repository) deleteAll(ctx context.Context, query *gorm.DB) error {
+	ids := query.Model(&entities.BudgetMovement{}).Select("id")
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("DELETE FROM budget_movement_tags WHERE budget_movement_id IN (?)", ids).Error; err != nil {
+			return err
+		}
+		return tx.Where("id IN (?)", ids).Delete(&entities.BudgetMovement{}).Error
+	})
+}
+
+func (r *repository) byInstallment(ctx context.Context, installmentId string) *gorm.DB {
+	return r.db.WithContext(ctx).Where("installment_id = ? AND type = ?", installmentId, string(models.MovementExpense))
+}
+
+func (r *repository) byOrigin(ctx context.Context, origin, movementType string, fromMonth, fromYear int) *gorm.DB {
+	query := r.db.WithContext(ctx).Where("origin = ? AND type = ?", origin, movementType)
+
+	if fromYear != 0 {
+		query = query.Where("year > ? OR (year = ? AND month >= ?)", fromYear, fromYear, fromMonth)
+	}
+	return query
+}
+
+// movementColumns seleciona a movimentação com a descrição da origem e o orçamento
+const movementColumns = `SELECT 
 		bm.id,
 		bm.budget_id,
 		bm.type,
 		bm.origin,
 		bm.month,
 		bm.year,
+		bm.date,
 		bm.amount,
 		bm.created_at,
-		COALESCE(i.description, e.description, b1.description) AS origin_description,
+		bm.counterpart_budget_id,
+		bm.reason,
+		bm.installment_id,
+		COALESCE(i.description, e.description, b1.description, bm.reason) AS origin_description,
 		b.id AS "budget__id",
 	b.description AS "budget__description",
 	b.amount AS "budget__amount",
 	b.end_date AS "budget__end_date",
 	b.created_at AS "budget__created_at",
 	b.updated_at AS "budget__updated_at"`
-	countColumns := `SELECT count(1)`
-	query := `
+
+// movementJoins liga a movimentação ao orçamento e às possíveis origens (receita, despesa ou orçamento)
+const movementJoins = `
 	FROM budget_movements bm
 	JOIN budgets b ON bm.budget_id = b.id
 	LEFT JOIN incomes i ON bm.origin = i.id AND bm.type = 'income'
-	LEFT JOIN expenses e ON bm.origin = e.id AND bm.type = 'expense'
+	LEFT JOIN expenses e ON bm.origin = e.id AND bm.type IN ('expense', 'reversal')
 	LEFT JOIN budgets b1 ON bm.origin = b1.id AND bm.type = 'budget'
+`
+
+// List implements Repository.
+func (r *repository) List(ctx context.Context, budgetId, movementType, origin string, month, year int, tag string, page models.PageRequest) (budgets []entities.BudgetMovement, count int64, err error) {
+	selectColumns := movementColumns
+	countColumns := `SELECT count(1)`
+	query := movementJoins + `
 	WHERE 1=1
 	`
 
@@ -88,10 +180,15 @@ func (r *repository) List(ctx context.Context, budgetId, movementType, origin st
 		args = append(args, year)
 	}
 
+	if tag != "" {
+		query += " AND bm.id IN (SELECT budget_movement_id FROM budget_movement_tags WHERE tag_name = ?)"
+		args = append(args, models.NormalizeTag(tag))
+	}
+
 	pagedQuery := query + " ORDER BY bm.created_at DESC LIMIT ? OFFSET ?"
 	pagedArgs := append(args, page.Limit, page.Offset())
 
-	if err := r.db.WithContext(ctx).Raw(selectColumns+pagedQuery, pagedArgs...).Preload("Budget").Find(&budgets).Error; err != nil {
+	if err := r.db.WithContext(ctx).Raw(selectColumns+pagedQuery, pagedArgs...).Preload("Budget", withDeleted).Preload("CounterpartBudget", withDeleted).Preload("Tags").Find(&budgets).Error; err != nil {
 		return nil, 0, fmt.Errorf("erro ao listar movimentações: %w", err)
 	}
 
@@ -102,24 +199,154 @@ func (r *repository) List(ctx context.Context, budgetId, movementType, origin st
 	return
 }
 
+// ListInPeriod retorna as movimentações do orçamento no período que contém a data informada, em ordem cronológica
+func (r *repository) ListInPeriod(ctx context.Context, budgetId string, at time.Time) (movements []entities.BudgetMovement, err error) {
+	query := movementColumns + movementJoins + `
+	WHERE bm.budget_id = ? AND ` + budget.InPeriodCondition + `
+	ORDER BY COALESCE(CAST(bm.date AS timestamptz), bm.created_at), bm.created_at, bm.id`
+	if err := r.db.WithContext(ctx).Raw(query, budgetId, at).Preload("CounterpartBudget", withDeleted).Preload("Tags").Find(&movements).Error; err != nil {
+		return nil, fmt.Errorf("erro ao listar movimentações do período: %w", err)
+	}
+	return
+}
+
+// CreateAllWithinBalance grava as movimentações só se o orçamento tiver ao menos amount de saldo no período.
+// A linha do orçamento fica travada até o fim da transação, então duas operações simultâneas não usam o mesmo saldo.
+// Quando o saldo não cobre amount, nada é gravado e o saldo é retornado com ok false.
+func (r *repository) CreateAllWithinBalance(ctx context.Context, budgetId string, at time.Time, amount money.Money, budgetMovements []entities.BudgetMovement) (balance money.Money, ok bool, err error) {
+	err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("SELECT id FROM budgets WHERE id = ? FOR UPDATE", budgetId).Error; err != nil {
+			return fmt.Errorf("erro ao travar orçamento: %w", err)
+		}
+
+		var err error
+		if balance, err = balanceInPeriod(tx, budgetId, at); err != nil {
+			return err
+		}
+		if balance < amount {
+			return nil
+		}
+
+		ok = true
+		return tx.CreateInBatches(budgetMovements, 50).Error
+	})
+	return
+}
+
+// balanceInPeriod retorna o saldo do orçamento no período que contém a data informada. Enquanto a
+// movimentação de início do período não foi gerada, o valor do orçamento é considerado como saldo inicial.
+func balanceInPeriod(db *gorm.DB, budgetId string, at time.Time) (balance money.Money, err error) {
+	query := `SELECT COALESCE((SELECT SUM(bm.amount)
+	                  FROM budget_movements bm
+	                  WHERE bm.budget_id = b.id AND ` + budget.InPeriodCondition + `), 0)
+	       + CASE WHEN EXISTS(SELECT 1
+	                          FROM budget_movements bm
+	                          WHERE bm.budget_id = b.id AND ` + budget.InPeriodCondition + ` AND bm.type = 'start')
+	              THEN 0 ELSE ` + budget.AmountInForce + ` END
+	FROM budgets b
+	WHERE b.id = ?`
+	if err := db.Raw(query, at, at, at, budgetId).Scan(&balance).Error; err != nil {
+		return money.Zero, fmt.Errorf("erro ao calcular saldo do orçamento: %w", err)
+	}
+	return
+}
+
+// ClosingBalance retorna o saldo com que o orçamento fechou o período que contém a data informada,
+// somando todas as suas movimentações. started indica se houve a movimentação de início naquele período.
+func (r *repository) ClosingBalance(ctx context.Context, budgetId string, at time.Time) (balance money.Money, started bool, err error) {
+	var result struct {
+		Balance money.Money
+		Started bool
+	}
+	query := `SELECT COALESCE(SUM(bm.amount), 0) AS balance,
+	       COALESCE(bool_or(bm.type = 'start'), false) AS started
+	FROM budget_movements bm
+	JOIN budgets b ON b.id = bm.budget_id
+	WHERE bm.budget_id = ? AND ` + budget.InPeriodCondition
+	if err := r.db.WithContext(ctx).Raw(query, budgetId, at).Scan(&result).Error; err != nil {
+		return money.Zero, false, fmt.Errorf("erro ao calcular saldo de fechamento do orçamento: %w", err)
+	}
+	return result.Balance, result.Started, nil
+}
+
+// PeriodUsage retorna o limite e o gasto do orçamento no período que contém a data informada.
+// O limite soma o início, o rollover, os ajustes e as transferências; o gasto soma as despesas e seus estornos.
+// Enquanto a movimentação de início do período não foi gerada, o valor do orçamento compõe o limite.
+func (r *repository) PeriodUsage(ctx context.Context, budgetId string, at time.Time) (limit, spent money.Money, err error) {
+	var result struct {
+		PeriodLimit money.Money
+		Spent       money.Money
+	}
+	query := `SELECT COALESCE(SUM(bm.amount) FILTER (WHERE bm.type NOT IN ('expense', 'reversal')), 0)
+	       + CASE WHEN bool_or(bm.type = 'start') THEN 0 ELSE MAX(` + budget.AmountInForce + `) END AS period_limit,
+	       -COALESCE(SUM(bm.amount) FILTER (WHERE bm.type IN ('expense', 'reversal')), 0) AS spent
+	FROM budgets b
+	LEFT JOIN budget_movements bm ON bm.budget_id = b.id AND ` + budget.InPeriodCondition + `
+	WHERE b.id = ?`
+	if err := r.db.WithContext(ctx).Raw(query, at, at, budgetId).Scan(&result).Error; err != nil {
+		return money.Zero, money.Zero, fmt.Errorf("erro ao calcular uso do orçamento no período: %w", err)
+	}
+	return result.PeriodLimit, result.Spent, nil
+}
+
 func (r *repository) SummaryBudgetUsageByMonthYear(ctx context.Context, month, year int) (data []views.SummaryBudgetUtilization, err error) {
-	query := `select description,
-			   bu.amount,
-			   coalesce(sum(bm.amount),0) usage
-				from budgets bu
-				left join budget_movements bm on bu.id = bm.budget_id and type != 'start'
-		where (end_date >= ? or end_date is null)
-		group by bu.amount, description
+	// cada orçamento é medido no seu próprio período (semana, mês, trimestre ou ano) que contém o mês consultado;
+	// o valor é o de abertura somado aos ajustes e transferências do período e o uso é só o que foi gasto
+	query := `select b.id,
+			   b.parent_id,
+			   b.description,
+			   b.period,
+			   coalesce(sum(bm.amount) filter (where bm.type not in ('expense', 'reversal')), 0)
+			     + case when bool_or(bm.type = 'start') then 0 else ` + budget.AmountInForce + ` end amount,
+			   -coalesce(sum(bm.amount) filter (where bm.type in ('expense', 'reversal')), 0) usage
+				from budgets b
+				left join budget_movements bm on b.id = bm.budget_id
+				  and ` + budget.InPeriodCondition + `
+		where (b.end_date >= ? or b.end_date is null)
+		  and b.deleted_at is null
+		group by b.id
 		order by usage desc`
-	firstOfNextMonth := time.Date(year, time.Month(month)+1, 1, 0, 0, 0, 0, time.UTC)
+	firstOfMonth := time.Date(year, time.Month(month), 1, 0, 0, 0, 0, time.UTC)
+	at := models.PeriodReference(month, year, time.Now())
 
-	if err := r.db.WithContext(ctx).Raw(query, firstOfNextMonth).Scan(&data).Error; err != nil {
+	if err := r.db.WithContext(ctx).Raw(query, at, at, firstOfMonth).Scan(&data).Error; err != nil {
 		return []views.SummaryBudgetUtilization{}, fmt.Errorf("erro ao buscar resumo de utilização do orçamento: %w", err)
 	}
 
 	return
 }
 
+// RecurringUsageByMonthYear retorna, por orçamento, o gasto já lançado de despesas recorrentes no período que contém o mês
+func (r *repository) RecurringUsageByMonthYear(ctx context.Context, month, year int) (map[string]money.Money, error) {
+	var rows []struct {
+		BudgetID string
+		Usage    money.Money
+	}
+	query := `select bm.budget_id, -sum(bm.amount) usage
+		from budget_movements bm
+		join budgets b on b.id = bm.budget_id
+		join expenses e on e.id = bm.origin and e.type = 'recurring'
+		where bm.type in ('expense', 'reversal')
+		  and ` + budget.InPeriodCondition + `
+		group by bm.budget_id`
+	at := models.PeriodReference(month, year, time.Now())
+
+	if err := r.db.WithContext(ctx).Raw(query, at).Scan(&rows).Error; err != nil {
+		return nil, fmt.Errorf("erro ao buscar gasto recorrente dos orçamentos: %w", err)
+	}
+
+	usage := make(map[string]money.Money, len(rows))
+	for _, row := range rows {
+		usage[row.BudgetID] = row.Usage
+	}
+	return usage, nil
+}
+
+// withDeleted carrega o orçamento da movimentação mesmo quando ele foi excluído
+func withDeleted(db *gorm.DB) *gorm.DB {
+	return db.Unscoped()
+}
+
 func NewRepository(db *gorm.DB) Repository {
 	return &repository{db: db}
 }
@@ -0,0 +1,17 @@
+package category
+
+import (
+	"context"
+
+	"financial-backend/internal/entities"
+)
+
+type Repository interface {
+	Create(ctx context.Context, category *entities.Category) error
+	Update(ctx context.Context, category *entities.Category) error
+	Delete(ctx context.Context, id string) error
+	Get(ctx context.Context, id string) (*entities.Category, error)
+	List(ctx context.Context) ([]entities.Category, error)
+	Descendants(ctx context.Context, id string) ([]string, error)
+	CountChildren(ctx context.Context, id string) (int64, error)
+}
@@ -0,0 +1,76 @@
+package category
+
+import (
+	"context"
+	"fmt"
+
+	"financial-backend/internal/entities"
+
+	"gorm.io/gorm"
+)
+
+// SubtreeQuery seleciona o id da categoria informada e de todas as suas descendentes
+const SubtreeQuery = `WITH RECURSIVE category_tree AS (
+	SELECT id FROM categories WHERE id = ?
+	UNION ALL
+	SELECT c.id FROM categories c JOIN category_tree t ON c.parent_id = t.id
+) SELECT id FROM category_tree`
+
+type repository struct {
+	db *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) Repository {
+	return &repository{db: db}
+}
+
+func (r *repository) Create(ctx context.Context, category *entities.Category) error {
+	return r.db.WithContext(ctx).Create(category).Error
+}
+
+func (r *repository) Update(ctx context.Context, category *entities.Category) error {
+	return r.db.WithContext(ctx).Omit("CreatedAt").Save(category).Error
+}
+
+// Delete remove a categoria e desvincula as despesas e receitas que a usavam, inclusive as excluídas
+func (r *repository) Delete(ctx context.Context, id string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Unscoped().Model(&entities.Expense{}).Where("category_id = ?", id).Update("category_id", nil).Error; err != nil {
+			return fmt.Errorf("erro ao desvincular despesas da categoria: %v", err)
+		}
+		if err := tx.Unscoped().Model(&entities.Income{}).Where("category_id = ?", id).Update("category_id", nil).Error; err != nil {
+			return fmt.Errorf("erro ao desvincular receitas da categoria: %v", err)
+		}
+		return tx.Where("id = ?", id).Delete(&entities.Category{}).Error
+	})
+}
+
+func (r *repository) Get(ctx context.Context, id string) (*entities.Category, error) {
+	var category entities.Category
+	if err := r.db.WithContext(ctx).First(&category, "id = ?", id).Error; err != nil {
+		return nil, fmt.Errorf("erro ao buscar categoria: %v", err)
+	}
+	return &category, nil
+}
+
+func (r *repository) List(ctx context.Context) (categories []entities.Category, err error) {
+	if err := r.db.WithContext(ctx).Order("name").Find(&categories).Error; err != nil {
+		return nil, fmt.Errorf("erro ao listar categorias: %v", err)
+	}
+	return
+}
+
+// Descendants retorna os ids da categoria e de todas as suas descendentes
+func (r *repository) Descendants(ctx context.Context, id string) (ids []string, err error) {
+	if err := r.db.WithContext(ctx).Raw(SubtreeQuery, id).Scan(&ids).Error; err != nil {
+		return nil, fmt.Errorf("erro ao buscar subcategorias: %v", err)
+	}
+	return
+}
+
+func (r *repository) CountChildren(ctx context.Context, id string) (count int64, err error) {
+	if err := r.db.WithContext(ctx).Model(&entities.Category{}).Where("parent_id = ?", id).Count(&count).Error; err != nil {
+		return 0, fmt.Errorf("erro ao contar subcategorias: %v", err)
+	}
+	return
+}
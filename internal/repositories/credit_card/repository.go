@@ -0,0 +1,19 @@
+package creditcard
+
+import (
+	"context"
+
+	"financial-backend/internal/entities"
+	"financial-backend/internal/models"
+)
+
+type Repository interface {
+	Create(ctx context.Context, card *entities.CreditCard) error
+	Update(ctx context.Context, card *entities.CreditCard) error
+	Delete(ctx context.Context, id string) error
+	CountExpenses(ctx context.Context, id string) (int64, error)
+	Get(ctx context.Context, id string) (*entities.CreditCard, error)
+	List(ctx context.Context, name string, page models.PageRequest) ([]entities.CreditCard, int64, error)
+	CreatePayment(ctx context.Context, payment *entities.CreditCardPayment, installments []entities.ExpenseInstallment) error
+	ListPayments(ctx context.Context, cardId string, year, month int) ([]entities.CreditCardPayment, error)
+}
@@ -0,0 +1,90 @@
+package creditcard
+
+import (
+	"context"
+	"fmt"
+
+	"financial-backend/internal/entities"
+	"financial-backend/internal/models"
+
+	"gorm.io/gorm"
+)
+
+type repository struct {
+	db *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) Repository {
+	return &repository{db: db}
+}
+
+func (r *repository) Create(ctx context.Context, card *entities.CreditCard) error {
+	return r.db.WithContext(ctx).Create(card).Error
+}
+
+func (r *repository) Update(ctx context.Context, card *entities.CreditCard) error {
+	return r.db.WithContext(ctx).Omit("CreatedAt").Save(card).Error
+}
+
+func (r *repository) Delete(ctx context.Context, id string) error {
+	return r.db.WithContext(ctx).Where("id = ?", id).Delete(&entities.CreditCard{}).Error
+}
+
+// CountExpenses conta as despesas lançadas no cartão, incluindo as excluídas, que ainda podem ser restauradas
+func (r *repository) CountExpenses(ctx context.Context, id string) (count int64, err error) {
+	if err := r.db.WithContext(ctx).Unscoped().Model(&entities.Expense{}).Where("card_id = ?", id).Count(&count).Error; err != nil {
+		return 0, fmt.Errorf("erro ao contar despesas do cartão: %v", err)
+	}
+	return
+}
+
+func (r *repository) Get(ctx context.Context, id string) (*entities.CreditCard, error) {
+	var card entities.CreditCard
+	if err := r.db.WithContext(ctx).First(&card, "id = ?", id).Error; err != nil {
+		return nil, fmt.Errorf("erro ao buscar cartão: %v", err)
+	}
+	return &card, nil
+}
+
+func (r *repository) List(ctx context.Context, name string, page models.PageRequest) (cards []entities.CreditCard, count int64, err error) {
+	query := r.db.WithContext(ctx)
+
+	if name != "" {
+		query = query.Where("name LIKE ?", "%"+name+"%")
+	}
+
+	if err := query.Offset(page.Offset()).Limit(int(page.Limit)).Find(&cards).Error; err != nil {
+		return nil, 0, fmt.Errorf("erro ao listar cartões: %v", err)
+	}
+
+	if err := query.Model(&entities.CreditCard{}).Count(&count).Error; err != nil {
+		return nil, 0, fmt.Errorf("erro ao contar cartões: %v", err)
+	}
+
+	return cards, count, nil
+}
+
+// CreatePayment grava o pagamento da fatura e as parcelas que ele quitou numa única transação
+func (r *repository) CreatePayment(ctx context.Context, payment *entities.CreditCardPayment, installments []entities.ExpenseInstallment) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(payment).Error; err != nil {
+			return fmt.Errorf("erro ao registrar pagamento da fatura: %v", err)
+		}
+		for _, installment := range installments {
+			if err := tx.Omit("CreatedAt").Save(&installment).Error; err != nil {
+				return fmt.Errorf("erro ao pagar parcela %d: %v", installment.Number, err)
+			}
+		}
+		return nil
+	})
+}
+
+func (r *repository) ListPayments(ctx context.Context, cardId string, year, month int) (payments []entities.CreditCardPayment, err error) {
+	if err := r.db.WithContext(ctx).
+		Where("card_id = ? AND year = ? AND month = ?", cardId, year, month).
+		Order("paid_at").
+		Find(&payments).Error; err != nil {
+		return nil, fmt.Errorf("erro ao listar pagamentos da fatura: %v", err)
+	}
+	return
+}
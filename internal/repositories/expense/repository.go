@@ -2,6 +2,7 @@ package expense
 
 import (
 	"context"
+	"time"
 
 	"financial-backend/internal/entities"
 	"financial-backend/internal/models"
@@ -12,7 +13,14 @@ type Repository interface {
 	Update(ctx context.Context, expense *entities.Expense) error
 	Delete(ctx context.Context, id string) error
 	Get(ctx context.Context, id string) (*entities.Expense, error)
-	List(ctx context.Context, description, expenseType, category, budgetId, recurrecy, method string, page models.PageRequest) ([]*entities.Expense, int64, error)
+	List(ctx context.Context, description, expenseType, categoryId, budgetId, recurrecy, method, tag string, includeDeleted bool, page models.PageRequest) ([]*entities.Expense, int64, error)
+	Restore(ctx context.Context, id string) error
 	GetExpensesWithoutMovimentInMonth(ctx context.Context) ([]*entities.Expense, error)
-	SummaryByMonth(ctx context.Context, month int, year int) (float64, error)
+	ListActiveBetween(ctx context.Context, from, to time.Time) ([]*entities.Expense, error)
+	ListShared(ctx context.Context) ([]*entities.Expense, error)
+	ListByCardBetween(ctx context.Context, cardId string, from, to time.Time) ([]*entities.Expense, error)
+	CreatePayment(ctx context.Context, payment *entities.ExpensePayment) error
+	ListPayments(ctx context.Context, expenseId string) ([]entities.ExpensePayment, error)
+	ListPaymentsBetween(ctx context.Context, from, to time.Time) ([]entities.ExpensePayment, error)
+	MarkOverdueNotified(ctx context.Context, expenseId string, occurrenceDate time.Time) (bool, error)
 }
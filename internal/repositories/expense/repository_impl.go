@@ -3,11 +3,14 @@ package expense
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"financial-backend/internal/entities"
 	"financial-backend/internal/models"
+	"financial-backend/internal/repositories/category"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type repository struct {
@@ -18,29 +21,76 @@ func NewRepository(db *gorm.DB) Repository {
 	return &repository{db: db}
 }
 
+// withDeleted carrega o orçamento da despesa mesmo quando ele foi excluído
+func withDeleted(db *gorm.DB) *gorm.DB {
+	return db.Unscoped()
+}
+
 func (r *repository) Create(ctx context.Context, expense *entities.Expense) error {
 	return r.db.WithContext(ctx).Create(expense).Error
 }
 
 func (r *repository) Update(ctx context.Context, expense *entities.Expense) error {
-	return r.db.WithContext(ctx).Save(expense).Error
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("CreatedAt", "Allocations", "Shares").Save(expense).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(expense).Association("Tags").Replace(expense.Tags); err != nil {
+			return err
+		}
+		if err := tx.Where("expense_id = ?", expense.ID).Delete(&entities.ExpenseAllocation{}).Error; err != nil {
+			return err
+		}
+		if len(expense.Allocations) > 0 {
+			if err := tx.Create(&expense.Allocations).Error; err != nil {
+				return err
+			}
+		}
+		if err := tx.Where("expense_id = ?", expense.ID).Delete(&entities.ExpenseShare{}).Error; err != nil {
+			return err
+		}
+		if len(expense.Shares) == 0 {
+			return nil
+		}
+		return tx.Create(&expense.Shares).Error
+	})
 }
 
+// Delete faz a exclusão lógica da despesa; tags, alocações e divisão são mantidas para uma eventual restauração
 func (r *repository) Delete(ctx context.Context, id string) error {
 	return r.db.WithContext(ctx).Where("id = ?", id).Delete(&entities.Expense{}).Error
 }
 
+// Restore desfaz a exclusão lógica da despesa
+func (r *repository) Restore(ctx context.Context, id string) error {
+	result := r.db.WithContext(ctx).Unscoped().
+		Model(&entities.Expense{}).
+		Where("id = ? AND deleted_at IS NOT NULL", id).
+		Update("deleted_at", nil)
+	if result.Error != nil {
+		return fmt.Errorf("erro ao restaurar despesa: %v", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("despesa %s não encontrada entre as excluídas", id)
+	}
+	return nil
+}
+
 func (r *repository) Get(ctx context.Context, id string) (*entities.Expense, error) {
 	var expense entities.Expense
-	if err := r.db.WithContext(ctx).First(&expense, "id = ?", id).Error; err != nil {
+	if err := r.db.WithContext(ctx).Preload("Tags").Preload("Allocations").Preload("Shares").First(&expense, "id = ?", id).Error; err != nil {
 		return nil, fmt.Errorf("erro ao buscar despesa: %v", err)
 	}
 	return &expense, nil
 }
 
-func (r *repository) List(ctx context.Context, description, expenseType, category, budgetId, recurrecy, method string, page models.PageRequest) (expenses []*entities.Expense, count int64, err error) {
+func (r *repository) List(ctx context.Context, description, expenseType, categoryId, budgetId, recurrecy, method, tag string, includeDeleted bool, page models.PageRequest) (expenses []*entities.Expense, count int64, err error) {
 	query := r.db.WithContext(ctx)
 
+	if includeDeleted {
+		query = query.Unscoped()
+	}
+
 	if description != "" {
 		query = query.Where("description like ?", "%"+description+"%")
 	}
@@ -49,31 +99,27 @@ func (r *repository) List(ctx context.Context, description, expenseType, categor
 		query = query.Where("type = ?", expenseType)
 	}
 
-	if category != "" {
-		query = query.Where("category = ?", category)
+	if categoryId != "" {
+		query = query.Where("category_id IN (?)", gorm.Expr(category.SubtreeQuery, categoryId))
 	}
 
 	if budgetId != "" {
-		query = query.Where("budget_id = ?", budgetId)
+		query = query.Where("budget_id = ? OR id IN (SELECT expense_id FROM expense_allocations WHERE budget_id = ?)", budgetId, budgetId)
 	}
 
 	if recurrecy != "" {
-		query = query.Where("recurrecy = ?", recurrecy)
+		query = query.Where("recurrency = ?", recurrecy)
 	}
 
 	if method != "" {
 		query = query.Where("method = ?", method)
 	}
 
-	if recurrecy != "" {
-		query = query.Where("recurrecy = ?", recurrecy)
+	if tag != "" {
+		query = query.Where("id IN (SELECT expense_id FROM expense_tags WHERE tag_name = ?)", models.NormalizeTag(tag))
 	}
 
-	if recurrecy != "" {
-		query = query.Where("recurrecy = ?", recurrecy)
-	}
-
-	if err := query.Preload("Budget").Offset(page.Offset()).Limit(int(page.Limit)).Find(&expenses).Error; err != nil {
+	if err := query.Preload("Budget", withDeleted).Preload("Tags").Preload("Allocations").Preload("Shares").Offset(page.Offset()).Limit(int(page.Limit)).Find(&expenses).Error; err != nil {
 		return nil, 0, fmt.Errorf("erro ao listar despesas: %v", err)
 	}
 
@@ -91,7 +137,8 @@ func (r *repository) GetExpensesWithoutMovimentInMonth(ctx context.Context) (exp
                  where method != 'credit_card'
                    and type = 'recurring'
                    and (end_date is null or end_date >= current_date)
-                   and budget_id is not null)
+                   and budget_id is not null
+                   and deleted_at is null)
 select e.*,
        b.description AS "budget__description",
        b.amount      AS "budget__amount",
@@ -104,23 +151,80 @@ from budget_movements bm
          join public.budgets b on e.budget_id = b.id
 where bm.id is null
 and e.budget_id is not null
+and b.deleted_at is null
 `
 
-	if err := r.db.WithContext(ctx).Raw(query).Preload("Budget").Find(&expenses).Error; err != nil {
+	if err := r.db.WithContext(ctx).Raw(query).Preload("Budget").Preload("Tags").Preload("Allocations").Preload("Shares").Find(&expenses).Error; err != nil {
 		return make([]*entities.Expense, 0), err
 	}
 
 	return
 }
-func (r *repository) SummaryByMonth(ctx context.Context, month int, year int) (float64, error) {
-	query := "select sum(amount) as total_expense from expenses  where start_date >= ? and (end_date <= ? or end_date is null)"
 
-	startDate := fmt.Sprintf("%d-%02d-01", year, month)
-	endDate := fmt.Sprintf("%d-%02d-01", year, month+1)
+// ListActiveBetween retorna as despesas vigentes em algum momento entre from e to
+func (r *repository) ListActiveBetween(ctx context.Context, from, to time.Time) (expenses []*entities.Expense, err error) {
+	if err := r.db.WithContext(ctx).
+		Where("start_date <= ? and (end_date is null or end_date >= ?)", to, from).
+		Preload("Tags").Preload("Allocations").Preload("Shares").
+		Find(&expenses).Error; err != nil {
+		return nil, fmt.Errorf("erro ao listar despesas vigentes: %v", err)
+	}
+	return
+}
 
-	var amount float64
-	if err := r.db.WithContext(ctx).Raw(query, startDate, endDate).Scan(&amount).Error; err != nil {
-		return 0, err
+// ListByCardBetween retorna as despesas do cartão vigentes em algum momento entre from e to
+func (r *repository) ListByCardBetween(ctx context.Context, cardId string, from, to time.Time) (expenses []*entities.Expense, err error) {
+	if err := r.db.WithContext(ctx).
+		Where("card_id = ? and start_date <= ? and (end_date is null or end_date >= ?)", cardId, to, from).
+		Order("start_date").
+		Find(&expenses).Error; err != nil {
+		return nil, fmt.Errorf("erro ao listar despesas do cartão: %v", err)
 	}
-	return amount, nil
+	return
+}
+
+func (r *repository) CreatePayment(ctx context.Context, payment *entities.ExpensePayment) error {
+	return r.db.WithContext(ctx).Create(payment).Error
+}
+
+func (r *repository) ListPayments(ctx context.Context, expenseId string) (payments []entities.ExpensePayment, err error) {
+	if err := r.db.WithContext(ctx).
+		Where("expense_id = ?", expenseId).
+		Order("occurrence_date").
+		Find(&payments).Error; err != nil {
+		return nil, fmt.Errorf("erro ao listar pagamentos da despesa: %v", err)
+	}
+	return
+}
+
+// MarkOverdueNotified registra a notificação de ocorrência vencida e retorna false quando ela já tinha sido registrada
+func (r *repository) MarkOverdueNotified(ctx context.Context, expenseId string, occurrenceDate time.Time) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&entities.ExpenseOverdueNotice{ExpenseID: expenseId, OccurrenceDate: occurrenceDate, CreatedAt: time.Now()})
+	if result.Error != nil {
+		return false, fmt.Errorf("erro ao registrar notificação de vencimento: %v", result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// ListPaymentsBetween retorna os pagamentos das ocorrências com vencimento entre from e to
+func (r *repository) ListPaymentsBetween(ctx context.Context, from, to time.Time) (payments []entities.ExpensePayment, err error) {
+	if err := r.db.WithContext(ctx).
+		Where("occurrence_date between ? and ?", from, to).
+		Find(&payments).Error; err != nil {
+		return nil, fmt.Errorf("erro ao listar pagamentos de despesas: %v", err)
+	}
+	return
+}
+
+// ListShared retorna as despesas compartilhadas entre membros
+func (r *repository) ListShared(ctx context.Context) (expenses []*entities.Expense, err error) {
+	if err := r.db.WithContext(ctx).
+		Where("paid_by_id is not null").
+		Preload("Shares").
+		Find(&expenses).Error; err != nil {
+		return nil, fmt.Errorf("erro ao listar despesas compartilhadas: %v", err)
+	}
+	return
 }
@@ -3,6 +3,7 @@ package income
 import (
 	. "context"
 	. "financial-backend/internal/entities"
+	"time"
 )
 
 // Repository defines the interface for income repository operations
@@ -13,14 +14,18 @@ type Repository interface {
 	// Update updates an existing income record
 	Update(ctx Context, income *Income) error
 
-	// Delete removes an income record by ID
+	// Delete soft deletes an income record by ID
 	Delete(ctx Context, id string) error
 
+	// Restore undoes the soft delete of an income record
+	Restore(ctx Context, id string) error
+
 	// Get retrieves an income record by ID
 	Get(ctx Context, id string) (*Income, error)
 
-	// List retrieves all income records
-	List(ctx Context, incomeType, description string, limit, offset int) ([]*Income, int64, error)
+	// List retrieves all income records, including soft deleted ones when includeDeleted is set
+	List(ctx Context, incomeType, description, categoryId, tag string, includeDeleted bool, limit, offset int) ([]*Income, int64, error)
 
-	SummaryByMonth(ctx Context, month, year int) (amount float64, err error)
+	// ListActiveBetween retrieves incomes valid at some point between from and to
+	ListActiveBetween(ctx Context, from, to time.Time) ([]*Income, error)
 }
@@ -6,6 +6,8 @@ import (
 	"time"
 
 	"financial-backend/internal/entities"
+	"financial-backend/internal/models"
+	"financial-backend/internal/repositories/category"
 
 	"gorm.io/gorm"
 )
@@ -23,27 +25,52 @@ func (r *repository) Create(ctx context.Context, income *entities.Income) error
 }
 
 func (r *repository) Update(ctx context.Context, income *entities.Income) error {
-	return r.db.WithContext(ctx).Save(income).Error
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("CreatedAt").Save(income).Error; err != nil {
+			return err
+		}
+		return tx.Model(income).Association("Tags").Replace(income.Tags)
+	})
 }
 
+// Delete faz a exclusão lógica da receita; as tags são mantidas para uma eventual restauração
 func (r *repository) Delete(ctx context.Context, id string) error {
 	return r.db.WithContext(ctx).Where("id = ?", id).Delete(&entities.Income{}).Error
 }
 
+// Restore desfaz a exclusão lógica da receita
+func (r *repository) Restore(ctx context.Context, id string) error {
+	result := r.db.WithContext(ctx).Unscoped().
+		Model(&entities.Income{}).
+		Where("id = ? AND deleted_at IS NOT NULL", id).
+		Update("deleted_at", nil)
+	if result.Error != nil {
+		return fmt.Errorf("erro ao restaurar receita: %v", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("receita %s não encontrada entre as excluídas", id)
+	}
+	return nil
+}
+
 func (r *repository) Get(ctx context.Context, id string) (*entities.Income, error) {
 	var income entities.Income
-	if err := r.db.WithContext(ctx).First(&income, "id = ?", id).Error; err != nil {
+	if err := r.db.WithContext(ctx).Preload("Tags").First(&income, "id = ?", id).Error; err != nil {
 		return nil, fmt.Errorf("erro ao buscar receita: %v", err)
 	}
 	return &income, nil
 }
 
-func (r *repository) List(ctx context.Context, incomeType, description string, limit, offset int) ([]*entities.Income, int64, error) {
+func (r *repository) List(ctx context.Context, incomeType, description, categoryId, tag string, includeDeleted bool, limit, offset int) ([]*entities.Income, int64, error) {
 	var incomes []*entities.Income
 	var count int64
 
 	query := r.db.WithContext(ctx)
 
+	if includeDeleted {
+		query = query.Unscoped()
+	}
+
 	if description != "" {
 		query = query.Where("description LIKE ?", "%"+description+"%")
 	}
@@ -52,7 +79,15 @@ func (r *repository) List(ctx context.Context, incomeType, description string, l
 		query = query.Where("type = ?", incomeType)
 	}
 
-	if err := query.Offset(offset).Limit(limit).Find(&incomes).Error; err != nil {
+	if categoryId != "" {
+		query = query.Where("category_id IN (?)", gorm.Expr(category.SubtreeQuery, categoryId))
+	}
+
+	if tag != "" {
+		query = query.Where("id IN (SELECT income_id FROM income_tags WHERE tag_name = ?)", models.NormalizeTag(tag))
+	}
+
+	if err := query.Preload("Tags").Offset(offset).Limit(limit).Find(&incomes).Error; err != nil {
 		return nil, 0, fmt.Errorf("erro ao listar receitas: %v", err)
 	}
 
@@ -62,13 +97,12 @@ func (r *repository) List(ctx context.Context, incomeType, description string, l
 	return incomes, count, nil
 }
 
-func (r *repository) SummaryByMonth(ctx context.Context, month, year int) (amount float64, err error) {
-	startDate := time.Date(year, time.Month(month), 1, 0, 0, 0, 0, time.UTC)
-	endDate := startDate.AddDate(0, 1, 0).Add(-time.Nanosecond)
-	query := "select sum(amount) as total_income  from incomes  where start_date >= ? and (end_date <= ? or end_date is null)"
-
-	if err := r.db.WithContext(ctx).Raw(query, startDate, endDate).Scan(&amount).Error; err != nil {
-		return 0, err
+func (r *repository) ListActiveBetween(ctx context.Context, from, to time.Time) (incomes []*entities.Income, err error) {
+	if err := r.db.WithContext(ctx).
+		Where("start_date <= ? and (end_date is null or end_date >= ?)", to, from).
+		Preload("Tags").
+		Find(&incomes).Error; err != nil {
+		return nil, fmt.Errorf("erro ao listar receitas vigentes: %v", err)
 	}
 	return
 }
@@ -0,0 +1,22 @@
+package installment
+
+import (
+	"context"
+	"time"
+
+	"financial-backend/internal/entities"
+	"financial-backend/internal/views"
+)
+
+type Repository interface {
+	CreateAll(ctx context.Context, installments []entities.ExpenseInstallment) error
+	Update(ctx context.Context, installment *entities.ExpenseInstallment) error
+	Get(ctx context.Context, id string) (*entities.ExpenseInstallment, error)
+	ListByExpense(ctx context.Context, expenseId string) ([]entities.ExpenseInstallment, error)
+	ListByCardDueBetween(ctx context.Context, cardId string, from, to time.Time) ([]entities.ExpenseInstallment, error)
+	DeleteOpenByExpense(ctx context.Context, expenseId string) error
+	CancelOpenByExpense(ctx context.Context, expenseId string) error
+	ReopenCancelledWithExpense(ctx context.Context, expenseId string) error
+	SummaryCommitted(ctx context.Context) ([]views.CommittedInstallments, error)
+	ExpensesWithoutInstallments(ctx context.Context) ([]string, error)
+}
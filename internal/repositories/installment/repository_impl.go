@@ -0,0 +1,125 @@
+package installment
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"financial-backend/internal/entities"
+	"financial-backend/internal/views"
+
+	"gorm.io/gorm"
+)
+
+// openStatuses são as situações das parcelas que ainda precisam ser pagas
+var openStatuses = []string{"pending", "overdue"}
+
+type repository struct {
+	db *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) Repository {
+	return &repository{db: db}
+}
+
+func (r *repository) CreateAll(ctx context.Context, installments []entities.ExpenseInstallment) error {
+	return r.db.WithContext(ctx).CreateInBatches(installments, 50).Error
+}
+
+func (r *repository) Update(ctx context.Context, installment *entities.ExpenseInstallment) error {
+	return r.db.WithContext(ctx).Omit("CreatedAt").Save(installment).Error
+}
+
+func (r *repository) Get(ctx context.Context, id string) (*entities.ExpenseInstallment, error) {
+	var installment entities.ExpenseInstallment
+	if err := r.db.WithContext(ctx).First(&installment, "id = ?", id).Error; err != nil {
+		return nil, fmt.Errorf("erro ao buscar parcela: %v", err)
+	}
+	return &installment, nil
+}
+
+func (r *repository) ListByExpense(ctx context.Context, expenseId string) (installments []entities.ExpenseInstallment, err error) {
+	if err := r.db.WithContext(ctx).
+		Where("expense_id = ?", expenseId).
+		Order("number").
+		Find(&installments).Error; err != nil {
+		return nil, fmt.Errorf("erro ao listar parcelas da despesa %s: %v", expenseId, err)
+	}
+	return
+}
+
+// ListByCardDueBetween retorna as parcelas não canceladas das despesas do cartão que vencem entre from e to,
+// pela data de vencimento gravada, independente da vigência da despesa
+func (r *repository) ListByCardDueBetween(ctx context.Context, cardId string, from, to time.Time) (installments []entities.ExpenseInstallment, err error) {
+	if err := r.db.WithContext(ctx).
+		Joins("JOIN expenses e ON e.id = expense_installments.expense_id AND e.deleted_at IS NULL").
+		Where("e.card_id = ? AND expense_installments.status <> ?", cardId, "cancelled").
+		Where("expense_installments.due_date >= ? AND expense_installments.due_date < ?", from, to.AddDate(0, 0, 1)).
+		Order("expense_installments.due_date, expense_installments.number").
+		Find(&installments).Error; err != nil {
+		return nil, fmt.Errorf("erro ao listar parcelas do cartão: %v", err)
+	}
+	return
+}
+
+// DeleteOpenByExpense remove as parcelas em aberto da despesa; as reagendadas à mão são mantidas
+func (r *repository) DeleteOpenByExpense(ctx context.Context, expenseId string) error {
+	if err := r.db.WithContext(ctx).
+		Where("expense_id = ? AND status IN ? AND NOT rescheduled", expenseId, openStatuses).
+		Delete(&entities.ExpenseInstallment{}).Error; err != nil {
+		return fmt.Errorf("erro ao remover parcelas da despesa %s: %v", expenseId, err)
+	}
+	return nil
+}
+
+func (r *repository) CancelOpenByExpense(ctx context.Context, expenseId string) error {
+	if err := r.db.WithContext(ctx).
+		Model(&entities.ExpenseInstallment{}).
+		Where("expense_id = ? AND status IN ?", expenseId, openStatuses).
+		Updates(map[string]interface{}{"status": "cancelled", "cancelled_with_expense": true, "updated_at": time.Now()}).Error; err != nil {
+		return fmt.Errorf("erro ao cancelar parcelas da despesa %s: %v", expenseId, err)
+	}
+	return nil
+}
+
+// ReopenCancelledWithExpense reabre as parcelas canceladas pela exclusão da despesa; as canceladas à mão continuam canceladas
+func (r *repository) ReopenCancelledWithExpense(ctx context.Context, expenseId string) error {
+	if err := r.db.WithContext(ctx).
+		Model(&entities.ExpenseInstallment{}).
+		Where("expense_id = ? AND cancelled_with_expense", expenseId).
+		Updates(map[string]interface{}{"status": "pending", "cancelled_with_expense": false, "updated_at": time.Now()}).Error; err != nil {
+		return fmt.Errorf("erro ao reabrir parcelas da despesa %s: %v", expenseId, err)
+	}
+	return nil
+}
+
+// SummaryCommitted agrupa por despesa as parcelas em aberto e o valor ainda comprometido
+func (r *repository) SummaryCommitted(ctx context.Context) (data []views.CommittedInstallments, err error) {
+	if err := r.db.WithContext(ctx).
+		Table("expense_installments ei").
+		Select(`ei.expense_id,
+			e.description,
+			COUNT(1) AS remaining,
+			COALESCE(SUM(ei.amount), 0) AS committed,
+			MIN(ei.due_date) AS next_due_date`).
+		Joins("JOIN expenses e ON e.id = ei.expense_id").
+		Where("ei.status IN ?", openStatuses).
+		Group("ei.expense_id, e.description").
+		Order("next_due_date").
+		Scan(&data).Error; err != nil {
+		return nil, fmt.Errorf("erro ao resumir parcelas em aberto: %v", err)
+	}
+	return
+}
+
+// ExpensesWithoutInstallments retorna as despesas parceladas ainda sem parcelas gravadas, criadas antes das parcelas existirem
+func (r *repository) ExpensesWithoutInstallments(ctx context.Context) (ids []string, err error) {
+	if err := r.db.WithContext(ctx).
+		Table("expenses e").
+		Where("e.installments IS NOT NULL AND e.deleted_at IS NULL").
+		Where("NOT EXISTS (SELECT 1 FROM expense_installments ei WHERE ei.expense_id = e.id)").
+		Pluck("e.id", &ids).Error; err != nil {
+		return nil, fmt.Errorf("erro ao buscar despesas parceladas sem parcelas: %v", err)
+	}
+	return
+}
@@ -0,0 +1,16 @@
+package member
+
+import (
+	"context"
+
+	"financial-backend/internal/entities"
+)
+
+type Repository interface {
+	Create(ctx context.Context, member *entities.Member) error
+	Update(ctx context.Context, member *entities.Member) error
+	Delete(ctx context.Context, id string) error
+	Get(ctx context.Context, id string) (*entities.Member, error)
+	List(ctx context.Context) ([]entities.Member, error)
+	CountUsages(ctx context.Context, id string) (int64, error)
+}
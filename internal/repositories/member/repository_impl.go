@@ -0,0 +1,57 @@
+package member
+
+import (
+	"context"
+	"fmt"
+
+	"financial-backend/internal/entities"
+
+	"gorm.io/gorm"
+)
+
+type repository struct {
+	db *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) Repository {
+	return &repository{db: db}
+}
+
+func (r *repository) Create(ctx context.Context, member *entities.Member) error {
+	return r.db.WithContext(ctx).Create(member).Error
+}
+
+func (r *repository) Update(ctx context.Context, member *entities.Member) error {
+	return r.db.WithContext(ctx).Omit("CreatedAt").Save(member).Error
+}
+
+func (r *repository) Delete(ctx context.Context, id string) error {
+	return r.db.WithContext(ctx).Where("id = ?", id).Delete(&entities.Member{}).Error
+}
+
+func (r *repository) Get(ctx context.Context, id string) (*entities.Member, error) {
+	var member entities.Member
+	if err := r.db.WithContext(ctx).First(&member, "id = ?", id).Error; err != nil {
+		return nil, fmt.Errorf("erro ao buscar membro: %v", err)
+	}
+	return &member, nil
+}
+
+func (r *repository) List(ctx context.Context) (members []entities.Member, err error) {
+	if err := r.db.WithContext(ctx).Order("name").Find(&members).Error; err != nil {
+		return nil, fmt.Errorf("erro ao listar membros: %v", err)
+	}
+	return
+}
+
+// CountUsages conta as despesas e reembolsos em que o membro aparece
+func (r *repository) CountUsages(ctx context.Context, id string) (count int64, err error) {
+	query := `SELECT
+		(SELECT count(1) FROM expenses WHERE paid_by_id = ?) +
+		(SELECT count(1) FROM expense_shares WHERE member_id = ?) +
+		(SELECT count(1) FROM settlements WHERE from_member_id = ? OR to_member_id = ?)`
+	if err := r.db.WithContext(ctx).Raw(query, id, id, id, id).Scan(&count).Error; err != nil {
+		return 0, fmt.Errorf("erro ao verificar uso do membro: %v", err)
+	}
+	return
+}
@@ -0,0 +1,12 @@
+package settlement
+
+import (
+	"context"
+
+	"financial-backend/internal/entities"
+)
+
+type Repository interface {
+	CreateAll(ctx context.Context, settlements []entities.Settlement) error
+	List(ctx context.Context) ([]entities.Settlement, error)
+}
@@ -0,0 +1,29 @@
+package settlement
+
+import (
+	"context"
+	"fmt"
+
+	"financial-backend/internal/entities"
+
+	"gorm.io/gorm"
+)
+
+type repository struct {
+	db *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) Repository {
+	return &repository{db: db}
+}
+
+func (r *repository) CreateAll(ctx context.Context, settlements []entities.Settlement) error {
+	return r.db.WithContext(ctx).Create(&settlements).Error
+}
+
+func (r *repository) List(ctx context.Context) (settlements []entities.Settlement, err error) {
+	if err := r.db.WithContext(ctx).Order("settled_at DESC").Find(&settlements).Error; err != nil {
+		return nil, fmt.Errorf("erro ao listar reembolsos: %v", err)
+	}
+	return
+}
@@ -0,0 +1,158 @@
+package attachment
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"financial-backend/internal/dtos"
+	"financial-backend/internal/gateways"
+	"financial-backend/internal/mappers"
+	"financial-backend/internal/models"
+	"financial-backend/pkg/storage"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type UseCase interface {
+	Upload(ctx context.Context, ownerType models.AttachmentOwner, ownerId, fileName, declaredType string, content io.Reader) (dtos.AttachmentResponse, error)
+	List(ctx context.Context, ownerType models.AttachmentOwner, ownerId string) ([]dtos.AttachmentResponse, error)
+	Download(ctx context.Context, ownerType models.AttachmentOwner, ownerId, id string) (dtos.AttachmentResponse, io.ReadCloser, error)
+	Delete(ctx context.Context, ownerType models.AttachmentOwner, ownerId, id string) error
+}
+
+type useCase struct {
+	gateway        gateways.AttachmentGateway
+	expenseGateway gateways.ExpenseGateway
+	incomeGateway  gateways.IncomeGateway
+	store          storage.BlobStore
+}
+
+func NewUseCase(
+	gateway gateways.AttachmentGateway,
+	expenseGateway gateways.ExpenseGateway,
+	incomeGateway gateways.IncomeGateway,
+	store storage.BlobStore,
+) UseCase {
+	return &useCase{
+		gateway:        gateway,
+		expenseGateway: expenseGateway,
+		incomeGateway:  incomeGateway,
+		store:          store,
+	}
+}
+
+// Upload grava o arquivo no armazenamento calculando tamanho e checksum durante a cópia.
+// O tipo é detectado pelo conteúdo; o tipo declarado só é usado quando a detecção não é conclusiva.
+func (uc *useCase) Upload(ctx context.Context, ownerType models.AttachmentOwner, ownerId, fileName, declaredType string, content io.Reader) (dtos.AttachmentResponse, error) {
+	if err := uc.ensureOwner(ctx, ownerType, ownerId); err != nil {
+		return dtos.AttachmentResponse{}, err
+	}
+
+	reader := bufio.NewReaderSize(content, 512)
+	head, _ := reader.Peek(512)
+	contentType := http.DetectContentType(head)
+	if contentType == "application/octet-stream" && declaredType != "" {
+		contentType = declaredType
+	}
+	if !models.AllowedAttachmentType(contentType) {
+		return dtos.AttachmentResponse{}, fmt.Errorf("tipo de arquivo não permitido: %s; envie PDF ou imagem", contentType)
+	}
+
+	id := uuid.New().String()
+	key := models.AttachmentStorageKey(ownerType, ownerId, id)
+	hash := sha256.New()
+	counter := &countingWriter{}
+	limited := io.LimitReader(reader, models.MaxAttachmentSize+1)
+
+	if err := uc.store.Put(ctx, key, io.TeeReader(limited, io.MultiWriter(hash, counter))); err != nil {
+		return dtos.AttachmentResponse{}, fmt.Errorf("erro ao armazenar anexo: %v", err)
+	}
+
+	attachment, err := models.NewAttachment(id, ownerType, ownerId, fileName, contentType, counter.size, hex.EncodeToString(hash.Sum(nil)), time.Now())
+	if err == nil {
+		err = uc.gateway.Create(ctx, attachment)
+	}
+	if err != nil {
+		uc.removeBlob(ctx, key)
+		return dtos.AttachmentResponse{}, err
+	}
+
+	return mappers.ToAttachmentResponse(attachment), nil
+}
+
+func (uc *useCase) List(ctx context.Context, ownerType models.AttachmentOwner, ownerId string) ([]dtos.AttachmentResponse, error) {
+	attachments, err := uc.gateway.ListByOwner(ctx, ownerType, ownerId)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]dtos.AttachmentResponse, len(attachments))
+	for i, attachment := range attachments {
+		responses[i] = mappers.ToAttachmentResponse(attachment)
+	}
+	return responses, nil
+}
+
+// Download retorna os metadados e o conteúdo do anexo; quem chama deve fechar o conteúdo
+func (uc *useCase) Download(ctx context.Context, ownerType models.AttachmentOwner, ownerId, id string) (dtos.AttachmentResponse, io.ReadCloser, error) {
+	attachment, err := uc.gateway.Get(ctx, ownerType, ownerId, id)
+	if err != nil {
+		return dtos.AttachmentResponse{}, nil, err
+	}
+
+	content, err := uc.store.Get(ctx, attachment.StorageKey())
+	if err != nil {
+		return dtos.AttachmentResponse{}, nil, fmt.Errorf("erro ao ler anexo: %v", err)
+	}
+
+	return mappers.ToAttachmentResponse(attachment), content, nil
+}
+
+func (uc *useCase) Delete(ctx context.Context, ownerType models.AttachmentOwner, ownerId, id string) error {
+	attachment, err := uc.gateway.Get(ctx, ownerType, ownerId, id)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.gateway.Delete(ctx, attachment.ID()); err != nil {
+		return fmt.Errorf("erro ao excluir anexo: %v", err)
+	}
+
+	uc.removeBlob(ctx, attachment.StorageKey())
+	return nil
+}
+
+func (uc *useCase) ensureOwner(ctx context.Context, ownerType models.AttachmentOwner, ownerId string) error {
+	var err error
+	switch ownerType {
+	case models.AttachmentOwnerExpense:
+		_, err = uc.expenseGateway.Get(ctx, ownerId)
+	case models.AttachmentOwnerIncome:
+		_, err = uc.incomeGateway.Get(ctx, ownerId)
+	default:
+		err = fmt.Errorf("tipo de dono do anexo inválido: %s", ownerType)
+	}
+	return err
+}
+
+// removeBlob apaga o arquivo do armazenamento; falhas só são registradas, pois os metadados já foram tratados
+func (uc *useCase) removeBlob(ctx context.Context, key string) {
+	if err := uc.store.Delete(ctx, key); err != nil {
+		log.Printf("erro ao remover arquivo %s do armazenamento: %v", key, err)
+	}
+}
+
+type countingWriter struct {
+	size int64
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	w.size += int64(len(p))
+	return len(p), nil
+}
@@ -2,11 +2,18 @@ package budget
 
 import (
 	"context"
+	"errors"
 	"financial-backend/internal/dtos"
 	"financial-backend/internal/gateways"
 	"financial-backend/internal/mappers"
 	"financial-backend/internal/models"
+	"financial-backend/pkg/money"
+	"fmt"
 	"math"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
 )
 
 type UseCase interface {
@@ -15,18 +22,31 @@ type UseCase interface {
 	Delete(ctx context.Context, id string) error
 	Get(ctx context.Context, id string) (dtos.BudgetResponse, error)
 	List(ctx context.Context, params dtos.BudgetListParams) (*models.Page[dtos.BudgetResponse], error)
+	Restore(ctx context.Context, id string) (dtos.BudgetResponse, error)
+	History(ctx context.Context, id string) ([]dtos.BudgetAmountHistoryResponse, error)
 }
 
 type useCase struct {
-	gateway gateways.BudgetGateway
+	gateway               gateways.BudgetGateway
+	budgetMovementGateway gateways.BudgetMovementGateway
 }
 
-func NewUseCase(gateway gateways.BudgetGateway) UseCase {
-	return &useCase{gateway: gateway}
+func NewUseCase(gateway gateways.BudgetGateway, budgetMovementGateway gateways.BudgetMovementGateway) UseCase {
+	return &useCase{
+		gateway:               gateway,
+		budgetMovementGateway: budgetMovementGateway,
+	}
 }
 
 func (uc *useCase) Create(ctx context.Context, dto dtos.CreateBudgetRequest) (dtos.BudgetResponse, error) {
-	budget := mappers.FromDTOToBudgetModel(dto)
+	budget, err := mappers.FromDTOToBudgetModel(dto)
+	if err != nil {
+		return dtos.BudgetResponse{}, err
+	}
+
+	if err := uc.validateParent(ctx, budget); err != nil {
+		return dtos.BudgetResponse{}, err
+	}
 
 	if err := uc.gateway.Create(ctx, budget); err != nil {
 		return dtos.BudgetResponse{}, err
@@ -41,29 +61,194 @@ func (uc *useCase) Update(ctx context.Context, id string, dto *dtos.UpdateBudget
 		return dtos.BudgetResponse{}, err
 	}
 
-	budget.SetEndDate(dto.EndDate)
+	if dto.EndDate != nil {
+		budget.SetEndDate(*dto.EndDate)
+	}
+
+	if dto.ParentID != nil {
+		parentId := dto.ParentID
+		if *parentId == "" {
+			parentId = nil
+		}
+		budget.SetParentId(parentId)
+		if err := uc.validateParent(ctx, budget); err != nil {
+			return dtos.BudgetResponse{}, err
+		}
+	}
+
+	if dto.RolloverPolicy != nil || dto.RolloverCap != nil {
+		policy := budget.RolloverPolicy()
+		if dto.RolloverPolicy != nil {
+			if policy, err = models.NewRolloverPolicy(*dto.RolloverPolicy); err != nil {
+				return dtos.BudgetResponse{}, err
+			}
+		}
+
+		cap := budget.RolloverCap()
+		if dto.RolloverCap != nil {
+			cap = dto.RolloverCap
+		}
+
+		if err := budget.SetRollover(policy, cap); err != nil {
+			return dtos.BudgetResponse{}, err
+		}
+	}
 
-	if err := uc.gateway.Update(ctx, budget); err != nil {
+	if dto.AlertThresholds != nil {
+		if err := budget.SetAlertThresholds(dto.AlertThresholds); err != nil {
+			return dtos.BudgetResponse{}, err
+		}
+	}
+
+	now := time.Now()
+	previous := budget.AmountAt(now)
+	var version models.BudgetAmountVersion
+	var movement models.BudgetMovement
+	if dto.Amount != nil {
+		if version, err = changeAmount(budget, dto, now); err != nil {
+			return dtos.BudgetResponse{}, err
+		}
+		if movement, err = uc.amountMovement(ctx, budget, version, previous, now); err != nil {
+			return dtos.BudgetResponse{}, err
+		}
+	}
+
+	if version != nil {
+		err = uc.gateway.UpdateAmount(ctx, budget, version, movement)
+	} else {
+		err = uc.gateway.Update(ctx, budget)
+	}
+	if err != nil {
 		return dtos.BudgetResponse{}, err
 	}
 
 	return mappers.ToBudgetResponse(budget), nil
 }
 
+// changeAmount cria a nova versão do valor do orçamento a partir de effective_from (padrão: mês atual)
+func changeAmount(budget models.Budget, dto *dtos.UpdateBudgetRequest, now time.Time) (models.BudgetAmountVersion, error) {
+	effectiveFrom := now
+	if dto.EffectiveFrom != nil {
+		effectiveFrom = *dto.EffectiveFrom
+	}
+
+	version, err := models.NewBudgetAmountVersion(uuid.New().String(), budget.ID(), *dto.Amount, effectiveFrom, dto.Reason, now)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := budget.ChangeAmount(version, now); err != nil {
+		return nil, err
+	}
+	return version, nil
+}
+
+// amountMovement monta o lançamento da nova versão do valor. Quando ela vale desde o período atual e a movimentação
+// de início já foi gerada, a diferença é lançada como aumento ou redução no período; caso contrário não há lançamento.
+func (uc *useCase) amountMovement(ctx context.Context, budget models.Budget, version models.BudgetAmountVersion, previous money.Money, now time.Time) (models.BudgetMovement, error) {
+	difference := budget.AmountAt(now).Sub(previous)
+	if difference.IsZero() {
+		return nil, nil
+	}
+
+	_, started, err := uc.budgetMovementGateway.ClosingBalance(ctx, budget.ID(), now)
+	if err != nil || !started {
+		return nil, err
+	}
+
+	movementType := models.MovementIncrease
+	if difference.IsNegative() {
+		movementType = models.MovementDecrease
+	}
+	reason := fmt.Sprintf("valor do orçamento alterado de %s para %s", previous, budget.AmountAt(now))
+	if version.Reason() != nil {
+		reason = *version.Reason()
+	}
+
+	return models.NewBudgetMovement(
+		uuid.New().String(),
+		budget.ID(),
+		budget,
+		version.ID(),
+		&reason,
+		int(now.Month()),
+		now.Year(),
+		movementType,
+		difference.Abs(),
+	), nil
+}
+
+// History retorna todos os valores que o orçamento já teve e o mês a partir do qual cada um vale
+func (uc *useCase) History(ctx context.Context, id string) ([]dtos.BudgetAmountHistoryResponse, error) {
+	budget, err := uc.gateway.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return mappers.ToBudgetAmountHistory(budget), nil
+}
+
+// validateParent garante que o orçamento pai existe, tem o mesmo período e não é um dos descendentes do orçamento
+func (uc *useCase) validateParent(ctx context.Context, budget models.Budget) error {
+	if budget.ParentId() == nil {
+		return nil
+	}
+
+	parent, err := uc.gateway.Get(ctx, *budget.ParentId())
+	if err != nil {
+		return fmt.Errorf("orçamento pai não encontrado: %v", err)
+	}
+	if parent.Period() != budget.Period() {
+		return fmt.Errorf("orçamento pai tem período %s e o filho %s; os períodos devem ser iguais", parent.Period(), budget.Period())
+	}
+
+	descendants, err := uc.gateway.Descendants(ctx, budget.ID())
+	if err != nil {
+		return err
+	}
+	if slices.Contains(descendants, parent.ID()) {
+		return errors.New("orçamento não pode ser filho de um dos seus descendentes")
+	}
+	return nil
+}
+
 func (uc *useCase) Delete(ctx context.Context, id string) error {
+	children, err := uc.gateway.CountChildren(ctx, id)
+	if err != nil {
+		return err
+	}
+	if children > 0 {
+		return errors.New("orçamento possui orçamentos filhos e não pode ser excluído")
+	}
 	return uc.gateway.Delete(ctx, id)
 }
 
+// Restore desfaz a exclusão do orçamento
+func (uc *useCase) Restore(ctx context.Context, id string) (dtos.BudgetResponse, error) {
+	if err := uc.gateway.Restore(ctx, id); err != nil {
+		return dtos.BudgetResponse{}, err
+	}
+	return uc.Get(ctx, id)
+}
+
+// Get retorna o orçamento com todos os seus descendentes aninhados
 func (uc *useCase) Get(ctx context.Context, id string) (dtos.BudgetResponse, error) {
 	budget, err := uc.gateway.Get(ctx, id)
 	if err != nil {
 		return dtos.BudgetResponse{}, err
 	}
-	return mappers.ToBudgetResponse(budget), nil
+
+	descendants, err := uc.gateway.ListDescendants(ctx, []string{id}, false)
+	if err != nil {
+		return dtos.BudgetResponse{}, err
+	}
+
+	response := mappers.ToBudgetResponse(budget)
+	response.Children = mappers.ToBudgetTree(descendants, &id)
+	return response, nil
 }
 
 func (uc *useCase) List(ctx context.Context, dto dtos.BudgetListParams) (*models.Page[dtos.BudgetResponse], error) {
-	budgets, count, err := uc.gateway.List(ctx, dto.Status, dto.Description, models.PageRequest{
+	budgets, count, err := uc.gateway.List(ctx, dto.Status, dto.Description, dto.IncludeDeleted, models.PageRequest{
 		Page:  dto.Page,
 		Limit: dto.Limit,
 	})
@@ -71,9 +256,21 @@ func (uc *useCase) List(ctx context.Context, dto dtos.BudgetListParams) (*models
 		return nil, err
 	}
 
+	ids := make([]string, len(budgets))
+	for i, budget := range budgets {
+		ids[i] = budget.ID()
+	}
+
+	descendants, err := uc.gateway.ListDescendants(ctx, ids, dto.IncludeDeleted)
+	if err != nil {
+		return nil, err
+	}
+
 	responses := make([]dtos.BudgetResponse, len(budgets))
 	for i, budget := range budgets {
+		id := budget.ID()
 		responses[i] = mappers.ToBudgetResponse(budget)
+		responses[i].Children = mappers.ToBudgetTree(descendants, &id)
 	}
 	return &models.Page[dtos.BudgetResponse]{
 		Page:       dto.Page,
@@ -0,0 +1,92 @@
+package budgetadjustment
+
+import (
+	"context"
+	"financial-backend/internal/dtos"
+	"financial-backend/internal/gateways"
+	"financial-backend/internal/mappers"
+	"financial-backend/internal/models"
+	"financial-backend/internal/models/events"
+	budgetalert "financial-backend/internal/usecases/budget_alert"
+	"financial-backend/pkg/config"
+	"fmt"
+	"log"
+
+	"github.com/google/uuid"
+)
+
+type UseCase interface {
+	Adjust(ctx context.Context, budgetId string, request dtos.BudgetAdjustmentRequest) (dtos.BudgetAdjustmentResponse, error)
+}
+
+type useCase struct {
+	budgetGateway         gateways.BudgetGateway
+	budgetMovementGateway gateways.BudgetMovementGateway
+	eventPublisher        config.Publisher
+	alerts                budgetalert.UseCase
+}
+
+func NewUseCase(
+	budgetGateway gateways.BudgetGateway,
+	budgetMovementGateway gateways.BudgetMovementGateway,
+	eventPublisher config.Publisher,
+	alerts budgetalert.UseCase,
+) UseCase {
+	return &useCase{
+		budgetGateway:         budgetGateway,
+		budgetMovementGateway: budgetMovementGateway,
+		eventPublisher:        eventPublisher,
+		alerts:                alerts,
+	}
+}
+
+// Adjust aumenta ou reduz um orçamento ativo em cada mês do período informado
+func (uc *useCase) Adjust(ctx context.Context, budgetId string, request dtos.BudgetAdjustmentRequest) (dtos.BudgetAdjustmentResponse, error) {
+	budget, err := uc.budgetGateway.Get(ctx, budgetId)
+	if err != nil {
+		return dtos.BudgetAdjustmentResponse{}, fmt.Errorf("orçamento não encontrado: %v", err)
+	}
+
+	from, to, err := request.Period()
+	if err != nil {
+		return dtos.BudgetAdjustmentResponse{}, err
+	}
+
+	adjustmentId := uuid.New().String()
+	movements, err := models.NewBudgetAdjustments(
+		adjustmentId,
+		budget,
+		models.MovementType(request.Type),
+		request.Amount,
+		request.Reason,
+		from,
+		to,
+		func() string { return uuid.New().String() },
+	)
+	if err != nil {
+		return dtos.BudgetAdjustmentResponse{}, err
+	}
+
+	if err := uc.budgetMovementGateway.CreateAll(ctx, movements); err != nil {
+		return dtos.BudgetAdjustmentResponse{}, fmt.Errorf("erro ao registrar ajuste: %v", err)
+	}
+	if err := uc.alerts.Check(ctx, movements); err != nil {
+		log.Printf("erro ao verificar limites de alerta do orçamento %s: %v", budgetId, err)
+	}
+
+	uc.eventPublisher.Publish(&events.BudgetAdjustedEvent{
+		Budget:    budget,
+		Movements: movements,
+		Reason:    request.Reason,
+		Context:   ctx,
+	})
+
+	response := dtos.BudgetAdjustmentResponse{
+		AdjustmentId: adjustmentId,
+		Movements:    make([]dtos.BudgetMovementResponse, len(movements)),
+	}
+	for i, movement := range movements {
+		response.Movements[i] = mappers.ToBudgetMovementDTO(movement)
+	}
+	return response, nil
+}
@@ -0,0 +1,163 @@
+package budgetalert
+
+import (
+	"context"
+	"financial-backend/internal/dtos"
+	"financial-backend/internal/gateways"
+	"financial-backend/internal/mappers"
+	"financial-backend/internal/models"
+	"financial-backend/internal/models/events"
+	"financial-backend/pkg/config"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type UseCase interface {
+	// Check verifica se as movimentações fizeram algum orçamento atingir um limite de alerta no período
+	Check(ctx context.Context, movements []models.BudgetMovement) error
+	CreateNotifier(ctx context.Context, budgetId string, request dtos.BudgetNotifierRequest) (dtos.BudgetNotifierResponse, error)
+	ListNotifiers(ctx context.Context, budgetId string) ([]dtos.BudgetNotifierResponse, error)
+	DeleteNotifier(ctx context.Context, budgetId, id string) error
+	ListAlerts(ctx context.Context, budgetId string) ([]dtos.BudgetAlertResponse, error)
+}
+
+type useCase struct {
+	budgetGateway         gateways.BudgetGateway
+	budgetMovementGateway gateways.BudgetMovementGateway
+	gateway               gateways.BudgetAlertGateway
+	eventPublisher        config.Publisher
+	defaultThresholds     []int
+}
+
+func NewUseCase(
+	budgetGateway gateways.BudgetGateway,
+	budgetMovementGateway gateways.BudgetMovementGateway,
+	gateway gateways.BudgetAlertGateway,
+	eventPublisher config.Publisher,
+	defaultThresholds []int,
+) UseCase {
+	return &useCase{
+		budgetGateway:         budgetGateway,
+		budgetMovementGateway: budgetMovementGateway,
+		gateway:               gateway,
+		eventPublisher:        eventPublisher,
+		defaultThresholds:     defaultThresholds,
+	}
+}
+
+func (uc *useCase) Check(ctx context.Context, movements []models.BudgetMovement) error {
+	now := time.Now()
+	checked := map[string]bool{}
+	for _, movement := range movements {
+		at := models.PeriodReference(movement.Month(), movement.Year(), now)
+		key := fmt.Sprintf("%s:%d:%d", movement.BudgetId(), movement.Month(), movement.Year())
+		if checked[key] {
+			continue
+		}
+		checked[key] = true
+
+		if err := uc.checkBudget(ctx, movement.BudgetId(), at); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkBudget registra os limites atingidos no período que contém at e publica um evento para cada limite novo
+func (uc *useCase) checkBudget(ctx context.Context, budgetId string, at time.Time) error {
+	budget, err := uc.budgetGateway.Get(ctx, budgetId)
+	if err != nil {
+		return fmt.Errorf("orçamento não encontrado: %v", err)
+	}
+
+	thresholds := budget.AlertThresholds()
+	if len(thresholds) == 0 {
+		thresholds = uc.defaultThresholds
+	}
+
+	limit, spent, err := uc.budgetMovementGateway.PeriodUsage(ctx, budgetId, at)
+	if err != nil {
+		return err
+	}
+
+	crossed := models.CrossedThresholds(thresholds, limit, spent)
+	if len(crossed) == 0 {
+		return nil
+	}
+
+	periodStart, periodEnd := budget.Period().Range(at)
+	var notifiers []models.BudgetNotifier
+	for _, threshold := range crossed {
+		alert := models.NewBudgetAlert(uuid.New().String(), budgetId, threshold, periodStart, limit, spent)
+		created, err := uc.gateway.CreateAlert(ctx, alert)
+		if err != nil {
+			return err
+		}
+		if !created {
+			continue
+		}
+
+		if notifiers == nil {
+			if notifiers, err = uc.gateway.ListNotifiers(ctx, budgetId); err != nil {
+				return err
+			}
+		}
+
+		uc.eventPublisher.Publish(&events.BudgetThresholdCrossedEvent{
+			Budget:    budget,
+			Alert:     alert,
+			PeriodEnd: periodEnd,
+			Notifiers: notifiers,
+			Context:   ctx,
+		})
+	}
+	return nil
+}
+
+func (uc *useCase) CreateNotifier(ctx context.Context, budgetId string, request dtos.BudgetNotifierRequest) (dtos.BudgetNotifierResponse, error) {
+	if _, err := uc.budgetGateway.Get(ctx, budgetId); err != nil {
+		return dtos.BudgetNotifierResponse{}, fmt.Errorf("orçamento não encontrado: %v", err)
+	}
+
+	notifier, err := models.NewBudgetNotifier(uuid.New().String(), budgetId, models.NotifierChannel(request.Channel), request.Target, time.Now())
+	if err != nil {
+		return dtos.BudgetNotifierResponse{}, err
+	}
+
+	if err := uc.gateway.CreateNotifier(ctx, notifier); err != nil {
+		return dtos.BudgetNotifierResponse{}, fmt.Errorf("erro ao cadastrar notificador: %v", err)
+	}
+	return mappers.ToBudgetNotifierResponse(notifier), nil
+}
+
+func (uc *useCase) ListNotifiers(ctx context.Context, budgetId string) ([]dtos.BudgetNotifierResponse, error) {
+	notifiers, err := uc.gateway.ListNotifiers(ctx, budgetId)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]dtos.BudgetNotifierResponse, len(notifiers))
+	for i, notifier := range notifiers {
+		responses[i] = mappers.ToBudgetNotifierResponse(notifier)
+	}
+	return responses, nil
+}
+
+func (uc *useCase) DeleteNotifier(ctx context.Context, budgetId, id string) error {
+	return uc.gateway.DeleteNotifier(ctx, budgetId, id)
+}
+
+func (uc *useCase) ListAlerts(ctx context.Context, budgetId string) ([]dtos.BudgetAlertResponse, error) {
+	alerts, err := uc.gateway.ListAlerts(ctx, budgetId)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]dtos.BudgetAlertResponse, len(alerts))
+	for i, alert := range alerts {
+		responses[i] = mappers.ToBudgetAlertResponse(alert)
+	}
+	return responses, nil
+}
@@ -0,0 +1,26 @@
+package budgetmovement
+
+import (
+	"context"
+	"financial-backend/internal/dtos"
+	"financial-backend/internal/mappers"
+	"financial-backend/internal/models"
+	"fmt"
+	"time"
+)
+
+// Balance monta o extrato do orçamento no período que contém o mês informado, calculado a partir das movimentações
+func (uc *useCase) Balance(ctx context.Context, budgetId string, params dtos.BudgetBalanceParams) (dtos.BudgetBalanceResponse, error) {
+	budget, err := uc.budgetGatway.Get(ctx, budgetId)
+	if err != nil {
+		return dtos.BudgetBalanceResponse{}, fmt.Errorf("orçamento não encontrado: %v", err)
+	}
+
+	at := models.PeriodReference(params.Month, params.Year, time.Now())
+	movements, err := uc.gateway.ListInPeriod(ctx, budgetId, at)
+	if err != nil {
+		return dtos.BudgetBalanceResponse{}, err
+	}
+
+	return mappers.ToBudgetBalanceResponse(models.NewBudgetStatement(budget, movements, at)), nil
+}
@@ -5,6 +5,7 @@ import (
 	"financial-backend/internal/dtos"
 	"financial-backend/internal/mappers"
 	"financial-backend/internal/models"
+	"financial-backend/pkg/money"
 	"time"
 
 	"github.com/google/uuid"
@@ -17,37 +18,25 @@ func (uc *useCase) Create(ctx context.Context, request dtos.BudgetMovementReques
 	if err != nil {
 		return dtos.BudgetMovementResponse{}, err
 	}
+	uc.checkThresholds(ctx, []models.BudgetMovement{budgetMovement})
 
 	return mappers.ToBudgetMovementDTO(budgetMovement), nil
 }
 
+// CreateExpenseMovement gera as movimentações de uma despesa recém-criada. As despesas parceladas ficam de fora:
+// as suas movimentações saem das parcelas gravadas, em SyncInstallmentMovements.
 func (uc *useCase) CreateExpenseMovement(ctx context.Context, expense models.Expense) error {
-	if expense.BudgetId() == nil {
+	if expense.BudgetId() == nil || expense.Installments() != nil {
 		return nil
 	}
-	var budget models.Budget
 
-	if expense.Budget() == nil {
-		foundBudget, err := uc.budgetGatway.Get(ctx, *expense.BudgetId())
-		if err != nil {
-			return err
-		}
-		budget = foundBudget
-	} else {
-		budget = *expense.Budget()
-	}
-
-	if expense.Installments() == nil {
-		return uc.gateway.Create(ctx, buildMovementByExpense(expense, int(expense.StartDate().Month()), expense.StartDate().Year(), budget))
+	budget, err := uc.expenseBudget(ctx, expense)
+	if err != nil {
+		return err
 	}
 
-	for i := 0; i < *expense.Installments(); i++ {
-		date := expense.StartDate().AddDate(0, i, 0)
-		if err := uc.gateway.Create(ctx, buildMovementByExpense(expense, int(date.Month()), date.Year(), budget)); err != nil {
-			return err
-		}
-	}
-	return nil
+	startDate := expense.StartDate()
+	return uc.createAll(ctx, uc.buildMovementsInMonth(expense, int(startDate.Month()), startDate.Year(), budget))
 }
 
 func (uc *useCase) CreateRecurrencyMovements(ctx context.Context) error {
@@ -69,7 +58,7 @@ func (uc *useCase) CreateRecurrencyMovements(ctx context.Context) error {
 
 	movements = append(movements, budgetStartMovements...)
 
-	return uc.gateway.CreateAll(ctx, movements)
+	return uc.createAll(ctx, movements)
 }
 
 func (uc *useCase) createBudgetStartMovements(ctx context.Context) (movements []models.BudgetMovement, err error) {
@@ -80,11 +69,52 @@ func (uc *useCase) createBudgetStartMovements(ctx context.Context) (movements []
 
 	for _, budget := range budgets {
 		movements = append(movements, buildMovementByBudget(budget))
+
+		rollover, err := uc.buildRolloverMovement(ctx, budget, budget.Period().Previous(time.Now()))
+		if err != nil {
+			return movements, err
+		}
+		if rollover != nil {
+			movements = append(movements, rollover)
+		}
 	}
 
 	return
 }
 
+// buildRolloverMovement gera a movimentação que leva o saldo do período anterior (que contém previous)
+// para o período atual, conforme a política de rollover do orçamento. Retorna nil quando não há nada a levar.
+func (uc *useCase) buildRolloverMovement(ctx context.Context, budget models.Budget, previous time.Time) (models.BudgetMovement, error) {
+	if budget.RolloverPolicy() == models.RolloverNone {
+		return nil, nil
+	}
+
+	balance, started, err := uc.gateway.ClosingBalance(ctx, budget.ID(), previous)
+	if err != nil {
+		return nil, err
+	}
+	if !started {
+		return nil, nil
+	}
+
+	amount := models.RolloverAmount(budget.RolloverPolicy(), budget.RolloverCap(), balance)
+	if amount.IsZero() {
+		return nil, nil
+	}
+
+	return models.NewBudgetMovement(
+		uuid.New().String(),
+		budget.ID(),
+		budget,
+		budget.ID(),
+		nil,
+		int(time.Now().Month()),
+		time.Now().Year(),
+		models.MovementRollover,
+		amount,
+	), nil
+}
+
 func (uc *useCase) createExpenseRecurrencyMovements(ctx context.Context) ([]models.BudgetMovement, error) {
 	movements := []models.BudgetMovement{}
 	expenses, err := uc.expenseGateway.GetExpensesWithoutMovementInMonth(ctx)
@@ -96,31 +126,60 @@ func (uc *useCase) createExpenseRecurrencyMovements(ctx context.Context) ([]mode
 	actualDate := time.Now()
 
 	for _, expense := range expenses {
-		recurrency := expense.Recurrency()
-		if *recurrency == models.ExpenseRecurrencyWeekly {
-			for range uc.countWeekdayInMonth(actualDate.Year(), actualDate.Month(), time.Weekday(expense.DueDay())) {
-				movements = append(movements, buildMovementByExpense(expense, int(actualDate.Month()), actualDate.Year(), *expense.Budget()))
-			}
-			continue
-		}
-		movements = append(movements, buildMovementByExpense(expense, int(actualDate.Month()), actualDate.Year(), *expense.Budget()))
+		movements = append(movements, uc.buildMovementsInMonth(expense, int(actualDate.Month()), actualDate.Year(), *expense.Budget())...)
 	}
 
 	return movements, nil
 }
 
-func buildMovementByExpense(expense models.Expense, month, year int, budget models.Budget) models.BudgetMovement {
+// buildMovementsInMonth gera uma movimentação para cada ocorrência da despesa no mês informado, datada pela ocorrência
+func (uc *useCase) buildMovementsInMonth(expense models.Expense, month, year int, budget models.Budget) (movements []models.BudgetMovement) {
+	first, last := models.MonthRange(year, time.Month(month))
+	for _, occurrence := range models.NewExpenseSchedule(expense).Occurrences(first, last) {
+		for _, movement := range buildMovementsByExpense(expense, month, year, budget) {
+			movements = append(movements, movement.WithDate(occurrence.Date))
+		}
+	}
+	return
+}
+
+// expenseBudget retorna o orçamento da despesa, buscando-o quando não vier carregado
+func (uc *useCase) expenseBudget(ctx context.Context, expense models.Expense) (models.Budget, error) {
+	if expense.Budget() != nil {
+		return *expense.Budget(), nil
+	}
+	return uc.budgetGatway.Get(ctx, *expense.BudgetId())
+}
+
+// buildMovementsByExpense gera a movimentação da despesa no mês, uma por alocação quando a despesa é dividida entre orçamentos
+func buildMovementsByExpense(expense models.Expense, month, year int, budget models.Budget) []models.BudgetMovement {
+	if len(expense.Allocations()) == 0 {
+		return []models.BudgetMovement{buildMovementByExpense(expense, *expense.BudgetId(), expense.Amount(), month, year, budget)}
+	}
+
+	movements := make([]models.BudgetMovement, len(expense.Allocations()))
+	for i, allocation := range expense.Allocations() {
+		var allocationBudget models.Budget
+		if budget != nil && budget.ID() == allocation.BudgetId {
+			allocationBudget = budget
+		}
+		movements[i] = buildMovementByExpense(expense, allocation.BudgetId, allocation.Amount, month, year, allocationBudget)
+	}
+	return movements
+}
+
+func buildMovementByExpense(expense models.Expense, budgetId string, amount money.Money, month, year int, budget models.Budget) models.BudgetMovement {
 	return models.NewBudgetMovement(
 		uuid.New().String(),
-		*expense.BudgetId(),
+		budgetId,
 		budget,
 		expense.Id(),
 		nil,
 		month,
 		year,
 		models.MovementExpense,
-		int(expense.Amount()),
-	)
+		amount,
+	).WithTags(expense.Tags())
 }
 
 func buildMovementByBudget(budget models.Budget) models.BudgetMovement {
@@ -133,21 +192,6 @@ func buildMovementByBudget(budget models.Budget) models.BudgetMovement {
 		int(time.Now().Month()),
 		time.Now().Year(),
 		models.MovementStart,
-		int(budget.Amount()),
+		budget.AmountAt(time.Now()),
 	)
 }
-
-func (uc *useCase) countWeekdayInMonth(year int, month time.Month, weekday time.Weekday) int {
-	loc := time.UTC
-	firstDay := time.Date(year, month, 1, 0, 0, 0, 0, loc)
-	lastDay := firstDay.AddDate(0, 1, -1)
-
-	count := 0
-	for d := firstDay; !d.After(lastDay); d = d.AddDate(0, 0, 1) {
-		if d.Weekday() == weekday {
-			count++
-		}
-	}
-
-	return count
-}
@@ -20,6 +20,7 @@ func (uc *useCase) Find(ctx context.Context, params dtos.BudgetMovementParams) (
 		params.Origin,
 		params.Month,
 		params.Year,
+		params.Tag,
 		models.PageRequest{
 			Limit: params.Limit,
 			Page:  params.Page,
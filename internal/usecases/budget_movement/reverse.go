@@ -0,0 +1,104 @@
+package budgetmovement
+
+import (
+	"context"
+	"financial-backend/internal/models"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ReverseExpenseMovements desfaz as movimentações de uma despesa excluída.
+// Com keepPastMonths os meses anteriores ao atual ficam intactos e só o mês corrente e os futuros são desfeitos.
+func (uc *useCase) ReverseExpenseMovements(ctx context.Context, expenseId string, strategy models.MovementReversalStrategy, keepPastMonths bool) error {
+	fromMonth, fromYear := 0, 0
+	if keepPastMonths {
+		now := time.Now()
+		fromMonth, fromYear = int(now.Month()), now.Year()
+	}
+
+	if strategy != models.ReversalCompensate {
+		return uc.gateway.DeleteByOrigin(ctx, expenseId, models.MovementExpense, fromMonth, fromYear)
+	}
+
+	movements, err := uc.gateway.ListByOrigin(ctx, expenseId, models.MovementExpense, fromMonth, fromYear)
+	if err != nil {
+		return err
+	}
+
+	if len(movements) == 0 {
+		return nil
+	}
+
+	reversals := make([]models.BudgetMovement, len(movements))
+	for i, movement := range movements {
+		reversals[i] = buildReversalMovement(movement)
+	}
+
+	return uc.gateway.CreateAll(ctx, reversals)
+}
+
+// RestoreExpenseMovements refaz as movimentações de uma despesa restaurada.
+// Os estornos gerados na exclusão são descartados. Nas despesas recorrentes, todo mês desde o início que ficou sem
+// movimentação (apagada na exclusão) é regerado; as demais são regeradas como numa alteração.
+func (uc *useCase) RestoreExpenseMovements(ctx context.Context, expense models.Expense) error {
+	if err := uc.gateway.DeleteByOrigin(ctx, expense.Id(), models.MovementReversal, 0, 0); err != nil {
+		return err
+	}
+
+	if expense.Type() != models.ExpenseTypeRecurring || expense.Installments() != nil {
+		return uc.SyncExpenseMovements(ctx, expense)
+	}
+	return uc.restoreRecurringMovements(ctx, expense)
+}
+
+// restoreRecurringMovements regera as movimentações dos meses entre o início da despesa e o mês corrente que não têm
+// nenhuma; meses mantidos na exclusão (keep_past_months ou compensate) ficam como estão
+func (uc *useCase) restoreRecurringMovements(ctx context.Context, expense models.Expense) error {
+	now := time.Now()
+	current := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	first := time.Date(expense.StartDate().Year(), expense.StartDate().Month(), 1, 0, 0, 0, 0, time.UTC)
+	if expense.BudgetId() == nil || first.After(current) {
+		return uc.SyncExpenseMovements(ctx, expense)
+	}
+
+	existing, err := uc.gateway.ListByOrigin(ctx, expense.Id(), models.MovementExpense, 0, 0)
+	if err != nil {
+		return err
+	}
+
+	kept := map[time.Time]bool{}
+	for _, movement := range existing {
+		kept[time.Date(movement.Year(), time.Month(movement.Month()), 1, 0, 0, 0, 0, time.UTC)] = true
+	}
+
+	budget, err := uc.expenseBudget(ctx, expense)
+	if err != nil {
+		return err
+	}
+
+	var movements []models.BudgetMovement
+	for month := first; !month.After(current); month = month.AddDate(0, 1, 0) {
+		if expense.EndDate() != nil && month.After(*expense.EndDate()) {
+			break
+		}
+		if !kept[month] {
+			movements = append(movements, uc.buildMovementsInMonth(expense, int(month.Month()), month.Year(), budget)...)
+		}
+	}
+	return uc.createAll(ctx, movements)
+}
+
+func buildReversalMovement(movement models.BudgetMovement) models.BudgetMovement {
+	return models.NewBudgetMovement(
+		uuid.New().String(),
+		movement.BudgetId(),
+		movement.Budget(),
+		movement.Origin(),
+		nil,
+		movement.Month(),
+		movement.Year(),
+		models.MovementReversal,
+		movement.Amount().Neg(),
+	).WithTags(movement.Tags()).WithDate(movement.Date())
+}
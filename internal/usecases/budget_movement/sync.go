@@ -0,0 +1,102 @@
+package budgetmovement
+
+import (
+	"context"
+	"financial-backend/internal/models"
+	"time"
+)
+
+// SyncExpenseMovements reescreve as movimentações geradas por uma despesa depois que ela foi alterada.
+// Despesas avulsas têm todas as movimentações refeitas; nas recorrentes os meses anteriores ao atual são
+// preservados e apenas o mês corrente (ou o mês inicial, se futuro) é regerado. As parceladas são
+// sincronizadas a partir das parcelas gravadas, em SyncInstallmentMovements.
+func (uc *useCase) SyncExpenseMovements(ctx context.Context, expense models.Expense) error {
+	if expense.Installments() != nil {
+		return nil
+	}
+
+	if expense.Type() != models.ExpenseTypeRecurring {
+		if err := uc.gateway.DeleteByOrigin(ctx, expense.Id(), models.MovementExpense, 0, 0); err != nil {
+			return err
+		}
+		return uc.CreateExpenseMovement(ctx, expense)
+	}
+
+	now := time.Now()
+	if err := uc.gateway.DeleteByOrigin(ctx, expense.Id(), models.MovementExpense, int(now.Month()), now.Year()); err != nil {
+		return err
+	}
+
+	if expense.BudgetId() == nil {
+		return nil
+	}
+
+	date := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	if expense.StartDate().After(date) {
+		date = expense.StartDate()
+	}
+	if expense.EndDate() != nil && expense.EndDate().Before(date) {
+		return nil
+	}
+
+	budget, err := uc.expenseBudget(ctx, expense)
+	if err != nil {
+		return err
+	}
+
+	return uc.createAll(ctx, uc.buildMovementsInMonth(expense, int(date.Month()), date.Year(), budget))
+}
+
+// SyncInstallmentMovements refaz as movimentações de uma despesa parcelada a partir das parcelas gravadas:
+// cada parcela não cancelada gera, no mês do seu vencimento atual, uma movimentação por alocação ligada a ela
+func (uc *useCase) SyncInstallmentMovements(ctx context.Context, expense models.Expense) error {
+	if err := uc.gateway.DeleteByOrigin(ctx, expense.Id(), models.MovementExpense, 0, 0); err != nil {
+		return err
+	}
+
+	if expense.BudgetId() == nil || expense.Installments() == nil {
+		return nil
+	}
+
+	installments, err := uc.installments.ListByExpense(ctx, expense.Id())
+	if err != nil {
+		return err
+	}
+
+	budget, err := uc.expenseBudget(ctx, expense)
+	if err != nil {
+		return err
+	}
+
+	var movements []models.BudgetMovement
+	for _, installment := range installments {
+		if installment.Status() == models.InstallmentCancelled {
+			continue
+		}
+		dueDate := installment.DueDate()
+		for _, movement := range buildMovementsByExpense(expense, int(dueDate.Month()), dueDate.Year(), budget) {
+			movements = append(movements, movement.WithInstallment(installment.ID()).WithDate(dueDate))
+		}
+	}
+	return uc.createAll(ctx, movements)
+}
+
+// BackfillInstallmentMovements refaz, a partir das parcelas gravadas, as movimentações das despesas parceladas geradas
+// antes de as movimentações guardarem a parcela, e retorna quantas despesas foram refeitas
+func (uc *useCase) BackfillInstallmentMovements(ctx context.Context) (int, error) {
+	ids, err := uc.gateway.InstallmentExpensesWithoutLink(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	for i, id := range ids {
+		expense, err := uc.expenseGateway.Get(ctx, id)
+		if err != nil {
+			return i, err
+		}
+		if err := uc.SyncInstallmentMovements(ctx, expense); err != nil {
+			return i, err
+		}
+	}
+	return len(ids), nil
+}
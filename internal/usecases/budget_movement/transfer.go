@@ -0,0 +1,60 @@
+package budgetmovement
+
+import (
+	"context"
+	"financial-backend/internal/dtos"
+	"financial-backend/internal/mappers"
+	"financial-backend/internal/models"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Transfer move saldo do orçamento budgetId para o orçamento de destino no mês informado.
+// Sem force, a transferência é recusada quando a origem não tem saldo no período para cobri-la.
+func (uc *useCase) Transfer(ctx context.Context, budgetId string, request dtos.BudgetTransferRequest, force bool) (dtos.BudgetTransferResponse, error) {
+	source, err := uc.budgetGatway.Get(ctx, budgetId)
+	if err != nil {
+		return dtos.BudgetTransferResponse{}, fmt.Errorf("orçamento de origem não encontrado: %v", err)
+	}
+
+	target, err := uc.budgetGatway.Get(ctx, request.TargetBudgetId)
+	if err != nil {
+		return dtos.BudgetTransferResponse{}, fmt.Errorf("orçamento de destino não encontrado: %v", err)
+	}
+
+	transferId := uuid.New().String()
+	debit, credit, err := models.NewBudgetTransfer(transferId, source, target, request.Amount, request.Month, request.Year, request.Reason, newId)
+	if err != nil {
+		return dtos.BudgetTransferResponse{}, err
+	}
+
+	movements := []models.BudgetMovement{debit, credit}
+	if force {
+		if err := uc.gateway.CreateAll(ctx, movements); err != nil {
+			return dtos.BudgetTransferResponse{}, fmt.Errorf("erro ao registrar transferência: %v", err)
+		}
+	} else {
+		// o saldo é conferido na mesma transação que grava o par, com o orçamento de origem travado
+		at := models.PeriodReference(request.Month, request.Year, time.Now())
+		balance, ok, err := uc.gateway.CreateAllWithinBalance(ctx, budgetId, at, request.Amount, movements)
+		if err != nil {
+			return dtos.BudgetTransferResponse{}, fmt.Errorf("erro ao registrar transferência: %v", err)
+		}
+		if !ok {
+			return dtos.BudgetTransferResponse{}, fmt.Errorf("saldo insuficiente no orçamento de origem (%s); use force=true para transferir mesmo assim", balance)
+		}
+	}
+	uc.checkThresholds(ctx, movements)
+
+	return dtos.BudgetTransferResponse{
+		TransferId: transferId,
+		Debit:      mappers.ToBudgetMovementDTO(debit),
+		Credit:     mappers.ToBudgetMovementDTO(credit),
+	}, nil
+}
+
+func newId() string {
+	return uuid.New().String()
+}
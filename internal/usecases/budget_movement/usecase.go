@@ -5,6 +5,8 @@ import (
 	"financial-backend/internal/dtos"
 	"financial-backend/internal/gateways"
 	"financial-backend/internal/models"
+	budgetalert "financial-backend/internal/usecases/budget_alert"
+	"log"
 )
 
 type UseCase interface {
@@ -13,22 +15,51 @@ type UseCase interface {
 	Find(ctx context.Context, params dtos.BudgetMovementParams) (models.Page[dtos.BudgetMovementResponse], error)
 	CreateExpenseMovement(ctx context.Context, expense models.Expense) error
 	CreateRecurrencyMovements(ctx context.Context) error
+	SyncExpenseMovements(ctx context.Context, expense models.Expense) error
+	SyncInstallmentMovements(ctx context.Context, expense models.Expense) error
+	BackfillInstallmentMovements(ctx context.Context) (int, error)
+	ReverseExpenseMovements(ctx context.Context, expenseId string, strategy models.MovementReversalStrategy, keepPastMonths bool) error
+	RestoreExpenseMovements(ctx context.Context, expense models.Expense) error
+	Transfer(ctx context.Context, budgetId string, request dtos.BudgetTransferRequest, force bool) (dtos.BudgetTransferResponse, error)
+	Balance(ctx context.Context, budgetId string, params dtos.BudgetBalanceParams) (dtos.BudgetBalanceResponse, error)
 }
 
 type useCase struct {
 	gateway        gateways.BudgetMovementGateway
 	budgetGatway   gateways.BudgetGateway
 	expenseGateway gateways.ExpenseGateway
+	installments   gateways.InstallmentGateway
+	alerts         budgetalert.UseCase
 }
 
 func NewBudgetMovementUseCase(
 	gateway gateways.BudgetMovementGateway,
 	budgetGateway gateways.BudgetGateway,
 	expenseGateway gateways.ExpenseGateway,
+	installments gateways.InstallmentGateway,
+	alerts budgetalert.UseCase,
 ) UseCase {
 	return &useCase{
 		budgetGatway:   budgetGateway,
 		gateway:        gateway,
 		expenseGateway: expenseGateway,
+		installments:   installments,
+		alerts:         alerts,
+	}
+}
+
+// createAll grava as movimentações e verifica se algum orçamento atingiu um limite de alerta
+func (uc *useCase) createAll(ctx context.Context, movements []models.BudgetMovement) error {
+	if err := uc.gateway.CreateAll(ctx, movements); err != nil {
+		return err
+	}
+	uc.checkThresholds(ctx, movements)
+	return nil
+}
+
+// checkThresholds dispara os alertas de limite; uma falha aqui não desfaz as movimentações já gravadas
+func (uc *useCase) checkThresholds(ctx context.Context, movements []models.BudgetMovement) {
+	if err := uc.alerts.Check(ctx, movements); err != nil {
+		log.Printf("erro ao verificar limites de alerta dos orçamentos: %v", err)
 	}
 }
@@ -0,0 +1,131 @@
+package category
+
+import (
+	"context"
+	"errors"
+	"financial-backend/internal/dtos"
+	"financial-backend/internal/gateways"
+	"financial-backend/internal/mappers"
+	"financial-backend/internal/models"
+	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
+)
+
+type UseCase interface {
+	Create(ctx context.Context, dto *dtos.CreateCategoryRequest) (dtos.CategoryResponse, error)
+	Update(ctx context.Context, id string, dto *dtos.UpdateCategoryRequest) (dtos.CategoryResponse, error)
+	Delete(ctx context.Context, id string) error
+	Get(ctx context.Context, id string) (dtos.CategoryResponse, error)
+	List(ctx context.Context) ([]dtos.CategoryResponse, error)
+}
+
+type useCase struct {
+	gateway gateways.CategoryGateway
+}
+
+func NewUseCase(gateway gateways.CategoryGateway) UseCase {
+	return &useCase{gateway: gateway}
+}
+
+func (uc *useCase) Create(ctx context.Context, dto *dtos.CreateCategoryRequest) (dtos.CategoryResponse, error) {
+	if dto.ParentID != nil {
+		if _, err := uc.gateway.Get(ctx, *dto.ParentID); err != nil {
+			return dtos.CategoryResponse{}, fmt.Errorf("categoria pai não encontrada: %v", err)
+		}
+	}
+
+	category, err := models.NewCategory(uuid.New().String(), dto.Name, dto.ParentID)
+	if err != nil {
+		return dtos.CategoryResponse{}, err
+	}
+
+	if err := uc.gateway.Create(ctx, category); err != nil {
+		return dtos.CategoryResponse{}, fmt.Errorf("erro ao criar categoria: %v", err)
+	}
+
+	return mappers.ToCategoryResponse(category), nil
+}
+
+func (uc *useCase) Update(ctx context.Context, id string, dto *dtos.UpdateCategoryRequest) (dtos.CategoryResponse, error) {
+	current, err := uc.gateway.Get(ctx, id)
+	if err != nil {
+		return dtos.CategoryResponse{}, err
+	}
+
+	name := current.Name()
+	if dto.Name != nil {
+		name = *dto.Name
+	}
+
+	parentId := current.ParentId()
+	if dto.ParentID != nil {
+		parentId = dto.ParentID
+		if *parentId == "" {
+			parentId = nil
+		}
+	}
+
+	if parentId != nil {
+		if _, err := uc.gateway.Get(ctx, *parentId); err != nil {
+			return dtos.CategoryResponse{}, fmt.Errorf("categoria pai não encontrada: %v", err)
+		}
+
+		descendants, err := uc.gateway.Descendants(ctx, id)
+		if err != nil {
+			return dtos.CategoryResponse{}, err
+		}
+		if slices.Contains(descendants, *parentId) {
+			return dtos.CategoryResponse{}, errors.New("categoria não pode ser filha de uma das suas subcategorias")
+		}
+	}
+
+	category, err := models.NewCategory(id, name, parentId)
+	if err != nil {
+		return dtos.CategoryResponse{}, err
+	}
+
+	if err := uc.gateway.Update(ctx, category); err != nil {
+		return dtos.CategoryResponse{}, fmt.Errorf("erro ao atualizar categoria: %v", err)
+	}
+
+	return mappers.ToCategoryResponse(category), nil
+}
+
+func (uc *useCase) Delete(ctx context.Context, id string) error {
+	children, err := uc.gateway.CountChildren(ctx, id)
+	if err != nil {
+		return err
+	}
+	if children > 0 {
+		return errors.New("categoria possui subcategorias e não pode ser excluída")
+	}
+	return uc.gateway.Delete(ctx, id)
+}
+
+// Get retorna a categoria com toda a sua subárvore
+func (uc *useCase) Get(ctx context.Context, id string) (dtos.CategoryResponse, error) {
+	category, err := uc.gateway.Get(ctx, id)
+	if err != nil {
+		return dtos.CategoryResponse{}, err
+	}
+
+	categories, err := uc.gateway.List(ctx)
+	if err != nil {
+		return dtos.CategoryResponse{}, err
+	}
+
+	response := mappers.ToCategoryResponse(category)
+	response.Children = mappers.ToCategoryTree(categories, &id)
+	return response, nil
+}
+
+// List retorna as categorias raiz com as subcategorias aninhadas
+func (uc *useCase) List(ctx context.Context) ([]dtos.CategoryResponse, error) {
+	categories, err := uc.gateway.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return mappers.ToCategoryTree(categories, nil), nil
+}
@@ -0,0 +1,133 @@
+package creditcard
+
+import (
+	"context"
+	"errors"
+	"financial-backend/internal/dtos"
+	"financial-backend/internal/mappers"
+	"financial-backend/internal/models"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func (uc *useCase) Statement(ctx context.Context, id string, params dtos.StatementParams) (dtos.CreditCardStatementResponse, error) {
+	statement, err := uc.statement(ctx, id, params.Year, params.Month)
+	if err != nil {
+		return dtos.CreditCardStatementResponse{}, err
+	}
+	return mappers.ToCreditCardStatementResponse(statement), nil
+}
+
+func (uc *useCase) PayStatement(ctx context.Context, id string, params dtos.StatementParams, dto *dtos.PayStatementRequest) (dtos.CreditCardStatementResponse, error) {
+	statement, err := uc.statement(ctx, id, params.Year, params.Month)
+	if err != nil {
+		return dtos.CreditCardStatementResponse{}, err
+	}
+
+	if statement.Paid() {
+		return dtos.CreditCardStatementResponse{}, errors.New("fatura já está paga")
+	}
+
+	amount := statement.Total().Sub(statement.PaidAmount())
+	if dto.Amount != nil {
+		amount = *dto.Amount
+	}
+
+	paidAt := time.Now()
+	if dto.PaidAt != nil {
+		paidAt = *dto.PaidAt
+	}
+
+	payment, err := models.NewCreditCardPayment(uuid.New().String(), id, params.Year, params.Month, amount, paidAt)
+	if err != nil {
+		return dtos.CreditCardStatementResponse{}, err
+	}
+
+	statement = models.NewCreditCardStatement(
+		statement.Card(),
+		params.Year,
+		params.Month,
+		statementExpenses(statement),
+		statementInstallments(statement),
+		append(statement.Payments(), payment),
+	)
+
+	// as parcelas cobertas pelos pagamentos da fatura são gravadas como pagas junto com o pagamento
+	covered := statement.CoveredInstallments()
+	for _, installment := range covered {
+		if err := installment.Pay(paidAt); err != nil {
+			return dtos.CreditCardStatementResponse{}, err
+		}
+	}
+
+	if err := uc.gateway.CreatePayment(ctx, payment, covered); err != nil {
+		return dtos.CreditCardStatementResponse{}, err
+	}
+
+	return mappers.ToCreditCardStatementResponse(statement), nil
+}
+
+func (uc *useCase) statement(ctx context.Context, id string, year, month int) (models.CreditCardStatement, error) {
+	card, err := uc.gateway.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	first, last := models.MonthRange(year, time.Month(month))
+	expenses, err := uc.expenseGateway.ListByCardBetween(ctx, id, first, last)
+	if err != nil {
+		return nil, err
+	}
+
+	payments, err := uc.gateway.ListPayments(ctx, id, year, month)
+	if err != nil {
+		return nil, err
+	}
+
+	installments, err := uc.installmentGateway.ListByCardDueBetween(ctx, id, first, last)
+	if err != nil {
+		return nil, err
+	}
+
+	// parcelas reagendadas podem vencer fora da vigência da despesa, que então não veio na listagem acima
+	listed := make(map[string]bool, len(expenses))
+	for _, expense := range expenses {
+		listed[expense.Id()] = true
+	}
+	for _, installment := range installments {
+		if listed[installment.ExpenseId()] {
+			continue
+		}
+		expense, err := uc.expenseGateway.Get(ctx, installment.ExpenseId())
+		if err != nil {
+			return nil, err
+		}
+		listed[expense.Id()] = true
+		expenses = append(expenses, expense)
+	}
+
+	return models.NewCreditCardStatement(card, year, month, expenses, installments, payments), nil
+}
+
+// statementExpenses retorna as despesas distintas que compõem a fatura
+func statementExpenses(statement models.CreditCardStatement) (expenses []models.Expense) {
+	seen := map[string]bool{}
+	for _, item := range statement.Items() {
+		if !seen[item.Expense.Id()] {
+			seen[item.Expense.Id()] = true
+			expenses = append(expenses, item.Expense)
+		}
+	}
+	return
+}
+
+// statementInstallments retorna as parcelas gravadas ligadas aos itens da fatura
+func statementInstallments(statement models.CreditCardStatement) (installments []models.ExpenseInstallment) {
+	for _, item := range statement.Items() {
+		if item.Installment != nil {
+			installments = append(installments, item.Installment)
+		}
+	}
+	return
+}
@@ -0,0 +1,128 @@
+package creditcard
+
+import (
+	"context"
+	"errors"
+	"financial-backend/internal/dtos"
+	"financial-backend/internal/gateways"
+	"financial-backend/internal/mappers"
+	"financial-backend/internal/models"
+	"math"
+
+	"github.com/google/uuid"
+)
+
+type UseCase interface {
+	Create(ctx context.Context, dto dtos.CreateCreditCardRequest) (dtos.CreditCardResponse, error)
+	Update(ctx context.Context, id string, dto *dtos.UpdateCreditCardRequest) (dtos.CreditCardResponse, error)
+	Delete(ctx context.Context, id string) error
+	Get(ctx context.Context, id string) (dtos.CreditCardResponse, error)
+	List(ctx context.Context, params dtos.CreditCardListParams) (*models.Page[dtos.CreditCardResponse], error)
+	Statement(ctx context.Context, id string, params dtos.StatementParams) (dtos.CreditCardStatementResponse, error)
+	PayStatement(ctx context.Context, id string, params dtos.StatementParams, dto *dtos.PayStatementRequest) (dtos.CreditCardStatementResponse, error)
+}
+
+type useCase struct {
+	gateway            gateways.CreditCardGateway
+	expenseGateway     gateways.ExpenseGateway
+	installmentGateway gateways.InstallmentGateway
+}
+
+func NewUseCase(gateway gateways.CreditCardGateway, expenseGateway gateways.ExpenseGateway, installmentGateway gateways.InstallmentGateway) UseCase {
+	return &useCase{
+		gateway:            gateway,
+		expenseGateway:     expenseGateway,
+		installmentGateway: installmentGateway,
+	}
+}
+
+func (uc *useCase) Create(ctx context.Context, dto dtos.CreateCreditCardRequest) (dtos.CreditCardResponse, error) {
+	card, err := models.NewCreditCard(uuid.New().String(), dto.Name, dto.ClosingDay, dto.DueDay, dto.Limit)
+	if err != nil {
+		return dtos.CreditCardResponse{}, err
+	}
+
+	if err := uc.gateway.Create(ctx, card); err != nil {
+		return dtos.CreditCardResponse{}, err
+	}
+
+	return mappers.ToCreditCardResponse(card), nil
+}
+
+func (uc *useCase) Update(ctx context.Context, id string, dto *dtos.UpdateCreditCardRequest) (dtos.CreditCardResponse, error) {
+	current, err := uc.gateway.Get(ctx, id)
+	if err != nil {
+		return dtos.CreditCardResponse{}, err
+	}
+
+	name := current.Name()
+	if dto.Name != nil {
+		name = *dto.Name
+	}
+
+	closingDay := current.ClosingDay()
+	if dto.ClosingDay != nil {
+		closingDay = *dto.ClosingDay
+	}
+
+	dueDay := current.DueDay()
+	if dto.DueDay != nil {
+		dueDay = *dto.DueDay
+	}
+
+	limit := current.Limit()
+	if dto.Limit != nil {
+		limit = *dto.Limit
+	}
+
+	card, err := models.NewCreditCard(current.ID(), name, closingDay, dueDay, limit)
+	if err != nil {
+		return dtos.CreditCardResponse{}, err
+	}
+
+	if err := uc.gateway.Update(ctx, card); err != nil {
+		return dtos.CreditCardResponse{}, err
+	}
+
+	return mappers.ToCreditCardResponse(card), nil
+}
+
+func (uc *useCase) Delete(ctx context.Context, id string) error {
+	expenses, err := uc.gateway.CountExpenses(ctx, id)
+	if err != nil {
+		return err
+	}
+	if expenses > 0 {
+		return errors.New("cartão possui despesas lançadas e não pode ser excluído")
+	}
+	return uc.gateway.Delete(ctx, id)
+}
+
+func (uc *useCase) Get(ctx context.Context, id string) (dtos.CreditCardResponse, error) {
+	card, err := uc.gateway.Get(ctx, id)
+	if err != nil {
+		return dtos.CreditCardResponse{}, err
+	}
+	return mappers.ToCreditCardResponse(card), nil
+}
+
+func (uc *useCase) List(ctx context.Context, params dtos.CreditCardListParams) (*models.Page[dtos.CreditCardResponse], error) {
+	cards, count, err := uc.gateway.List(ctx, params.Name, models.PageRequest{
+		Page:  params.Page,
+		Limit: params.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]dtos.CreditCardResponse, len(cards))
+	for i, card := range cards {
+		responses[i] = mappers.ToCreditCardResponse(card)
+	}
+	return &models.Page[dtos.CreditCardResponse]{
+		Page:       params.Page,
+		Limit:      params.Limit,
+		TotalPages: int64(math.Ceil(float64(count) / float64(params.Limit))),
+		Results:    responses,
+	}, nil
+}
@@ -0,0 +1,117 @@
+package dashboard
+
+import (
+	"context"
+	"math"
+	"sort"
+	"time"
+
+	"financial-backend/internal/models"
+	"financial-backend/internal/views"
+	"financial-backend/pkg/money"
+)
+
+// BudgetForecast projeta o gasto de cada orçamento até o fim do período que contém o mês.
+// As despesas recorrentes ainda sem movimentação só são conhecidas para o mês corrente.
+func (u *useCase) BudgetForecast(ctx context.Context, month, year int) ([]views.BudgetForecast, error) {
+	now := time.Now()
+
+	data, err := u.budgetMovementGateway.SummaryBudgetUsageByMonthYear(ctx, month, year)
+	if err != nil {
+		return nil, err
+	}
+
+	recurring, err := u.budgetMovementGateway.RecurringUsageByMonthYear(ctx, month, year)
+	if err != nil {
+		return nil, err
+	}
+
+	pending := map[string]money.Money{}
+	if month == int(now.Month()) && year == now.Year() {
+		if pending, err = u.pendingRecurring(ctx, data, now); err != nil {
+			return nil, err
+		}
+	}
+
+	forecasts := make([]views.BudgetForecast, len(data))
+	for i, row := range data {
+		row = withPace(row, month, year, now)
+		forecasts[i] = newBudgetForecast(row, recurring[row.ID], pending[row.ID])
+	}
+
+	sort.SliceStable(forecasts, func(i, j int) bool {
+		return forecasts[i].ProjectedPercent > forecasts[j].ProjectedPercent
+	})
+	return forecasts, nil
+}
+
+// newBudgetForecast extrapola o gasto variável pelos dias decorridos do período e soma as despesas recorrentes pelo valor
+func newBudgetForecast(row views.SummaryBudgetUtilization, recurring, pending money.Money) views.BudgetForecast {
+	projected := row.Usage
+	if row.ElapsedDays > 0 && row.ElapsedDays < row.PeriodDays {
+		variable := row.Usage.Sub(recurring)
+		projected = recurring.Add(money.Money(int64(variable) * int64(row.PeriodDays) / int64(row.ElapsedDays)))
+	}
+	projected = projected.Add(pending)
+
+	return views.BudgetForecast{
+		ID:                 row.ID,
+		ParentID:           row.ParentID,
+		Description:        row.Description,
+		Period:             row.Period,
+		Amount:             row.Amount,
+		Usage:              row.Usage,
+		RecurringUsage:     recurring,
+		PendingRecurring:   pending,
+		ElapsedDays:        row.ElapsedDays,
+		PeriodDays:         row.PeriodDays,
+		ProjectedUsage:     projected,
+		ProjectedRemaining: row.Amount.Sub(projected),
+		ProjectedPercent:   math.Round(models.UsagePercent(row.Amount, projected)*100) / 100,
+		ProjectedOverspend: projected > row.Amount,
+	}
+}
+
+// pendingRecurring soma, por orçamento, as ocorrências do mês corrente das despesas recorrentes que ainda não geraram
+// movimentação, limitadas ao período de cada orçamento e divididas pelas alocações da despesa
+func (u *useCase) pendingRecurring(ctx context.Context, data []views.SummaryBudgetUtilization, now time.Time) (map[string]money.Money, error) {
+	expenses, err := u.expenseGateway.GetExpensesWithoutMovementInMonth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	periods := make(map[string]string, len(data))
+	for _, row := range data {
+		periods[row.ID] = row.Period
+	}
+
+	monthStart, monthEnd := models.MonthRange(now.Year(), now.Month())
+	occurrencesIn := func(expense models.Expense, budgetId string) int {
+		from, to := monthStart, monthEnd
+		if period, err := models.NewBudgetPeriod(periods[budgetId]); err == nil {
+			start, end := period.Range(now)
+			if start.After(from) {
+				from = start
+			}
+			if end.Before(to) {
+				to = end
+			}
+		}
+		return len(models.NewExpenseSchedule(expense).Occurrences(from, to))
+	}
+
+	pending := map[string]money.Money{}
+	for _, expense := range expenses {
+		if len(expense.Allocations()) == 0 {
+			budgetId := *expense.BudgetId()
+			count := occurrencesIn(expense, budgetId)
+			pending[budgetId] = pending[budgetId].Add(money.Money(int64(expense.Amount()) * int64(count)))
+			continue
+		}
+		for _, allocation := range expense.Allocations() {
+			count := occurrencesIn(expense, allocation.BudgetId)
+			pending[allocation.BudgetId] = pending[allocation.BudgetId].Add(money.Money(int64(allocation.Amount) * int64(count)))
+		}
+	}
+	return pending, nil
+}
@@ -0,0 +1,137 @@
+package dashboard
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"financial-backend/internal/models"
+	"financial-backend/internal/views"
+)
+
+// budgetUtilizationTree guarda a utilização de cada orçamento já somada à dos descendentes
+type budgetUtilizationTree struct {
+	rows     map[string]views.SummaryBudgetUtilization
+	children map[string][]string
+	roots    []string
+}
+
+// newBudgetUtilizationTree soma no orçamento pai o uso de todos os descendentes. O limite do pai é o seu próprio
+// valor, que já cobre os filhos; somar os dois contaria o mesmo dinheiro duas vezes. Só quando o pai não tem
+// valor próprio o limite passa a ser a soma dos limites dos filhos.
+// Orçamentos cujo pai não aparece na utilização (expirado ou excluído) são tratados como raiz.
+func newBudgetUtilizationTree(data []views.SummaryBudgetUtilization, month, year int, now time.Time) budgetUtilizationTree {
+	tree := budgetUtilizationTree{
+		rows:     make(map[string]views.SummaryBudgetUtilization, len(data)),
+		children: map[string][]string{},
+	}
+	for _, row := range data {
+		tree.rows[row.ID] = row
+	}
+	for _, row := range data {
+		if row.ParentID != nil {
+			if _, ok := tree.rows[*row.ParentID]; ok {
+				tree.children[*row.ParentID] = append(tree.children[*row.ParentID], row.ID)
+				continue
+			}
+		}
+		tree.roots = append(tree.roots, row.ID)
+	}
+
+	var rollup func(id string) views.SummaryBudgetUtilization
+	rollup = func(id string) views.SummaryBudgetUtilization {
+		row := tree.rows[id]
+		own := row.Amount.IsPositive()
+		row.AmountSource = views.AmountFromOwn
+		if !own && len(tree.children[id]) > 0 {
+			row.AmountSource = views.AmountFromChildren
+		}
+		for _, child := range tree.children[id] {
+			total := rollup(child)
+			if !own {
+				row.Amount = row.Amount.Add(total.Amount)
+			}
+			row.Usage = row.Usage.Add(total.Usage)
+		}
+		row.HasChildren = len(tree.children[id]) > 0
+		tree.rows[id] = withPace(row, month, year, now)
+		return row
+	}
+	for _, id := range tree.roots {
+		rollup(id)
+	}
+	return tree
+}
+
+// withPace calcula o saldo, o percentual gasto e o ritmo esperado do orçamento no período que contém o mês.
+// Períodos já encerrados contam como completos e períodos futuros como não iniciados.
+func withPace(row views.SummaryBudgetUtilization, month, year int, now time.Time) views.SummaryBudgetUtilization {
+	row.Remaining = row.Amount.Sub(row.Usage)
+	row.UsedPercent = models.UsagePercent(row.Amount, row.Usage)
+
+	period, err := models.NewBudgetPeriod(row.Period)
+	if err != nil {
+		period = models.BudgetMonthly
+	}
+	start, end := period.Range(models.PeriodReference(month, year, now))
+	row.PeriodDays = int(end.Sub(start).Hours()/24) + 1
+
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	switch {
+	case today.Before(start):
+		row.ElapsedDays = 0
+	case today.After(end):
+		row.ElapsedDays = row.PeriodDays
+	default:
+		row.ElapsedDays = int(today.Sub(start).Hours()/24) + 1
+	}
+
+	row.ExpectedPercent = math.Round(float64(row.ElapsedDays)/float64(row.PeriodDays)*10000) / 100
+	row.UsedPercent = math.Round(row.UsedPercent*100) / 100
+	row.OverPace = row.UsedPercent > row.ExpectedPercent
+	return row
+}
+
+func (t budgetUtilizationTree) list(ids []string) []views.SummaryBudgetUtilization {
+	rows := make([]views.SummaryBudgetUtilization, len(ids))
+	for i, id := range ids {
+		rows[i] = t.rows[id]
+	}
+	sort.SliceStable(rows, func(i, j int) bool {
+		return rows[i].Usage > rows[j].Usage
+	})
+	return rows
+}
+
+// SummaryBudgetUsageByMonthYear retorna os orçamentos raiz com o uso somado ao dos descendentes e o limite
+// calculado conforme newBudgetUtilizationTree
+func (u *useCase) SummaryBudgetUsageByMonthYear(ctx context.Context, month, year int) ([]views.SummaryBudgetUtilization, error) {
+	data, err := u.budgetMovementGateway.SummaryBudgetUsageByMonthYear(ctx, month, year)
+	if err != nil {
+		return nil, err
+	}
+
+	tree := newBudgetUtilizationTree(data, month, year, time.Now())
+	return tree.list(tree.roots), nil
+}
+
+// BudgetUtilizationDrillDown detalha a utilização de um orçamento pelos seus filhos diretos
+func (u *useCase) BudgetUtilizationDrillDown(ctx context.Context, id string, month, year int) (views.BudgetUtilizationDrillDown, error) {
+	data, err := u.budgetMovementGateway.SummaryBudgetUsageByMonthYear(ctx, month, year)
+	if err != nil {
+		return views.BudgetUtilizationDrillDown{}, err
+	}
+
+	tree := newBudgetUtilizationTree(data, month, year, time.Now())
+	row, ok := tree.rows[id]
+	if !ok {
+		return views.BudgetUtilizationDrillDown{}, fmt.Errorf("orçamento %s não está vigente em %02d/%d", id, month, year)
+	}
+
+	return views.BudgetUtilizationDrillDown{
+		SummaryBudgetUtilization: row,
+		Children:                 tree.list(tree.children[id]),
+	}, nil
+}
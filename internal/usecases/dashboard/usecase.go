@@ -3,25 +3,34 @@ package dashboard
 import (
 	. "context"
 	. "financial-backend/internal/gateways"
+	"financial-backend/internal/models"
 	"financial-backend/internal/views"
+	"financial-backend/pkg/money"
 	"golang.org/x/net/context"
+	"sort"
 	"sync"
+	"time"
 )
 
 type UseCase interface {
 	GetSummary(ctx Context, month, year int) (views.SummaryView, error)
 	SummaryBudgetUsageByMonthYear(ctx context.Context, month, year int) (data []views.SummaryBudgetUtilization, err error)
+	BudgetUtilizationDrillDown(ctx context.Context, id string, month, year int) (views.BudgetUtilizationDrillDown, error)
+	BudgetForecast(ctx context.Context, month, year int) ([]views.BudgetForecast, error)
+	InstallmentsSummary(ctx Context) (views.InstallmentsSummary, error)
+	TagBreakdown(ctx Context, month, year int) ([]views.TagBreakdown, error)
 }
 
 type useCase struct {
 	expenseGateway        ExpenseGateway
 	incomeGateway         IncomeGateway
 	budgetMovementGateway BudgetMovementGateway
+	installmentGateway    InstallmentGateway
 }
 
 func (u useCase) GetSummary(ctx Context, month, year int) (views.SummaryView, error) {
 	var wg sync.WaitGroup
-	var income, expense float64
+	var income, expense money.Money
 	var incomeErr, expenseErr error
 
 	// Chama o SummaryByMonth para a renda em paralelo
@@ -53,21 +62,88 @@ func (u useCase) GetSummary(ctx Context, month, year int) (views.SummaryView, er
 	return views.SummaryView{
 		TotalIncome:    income,
 		TotalExpense:   expense,
-		TotalRemaining: income - expense,
+		TotalRemaining: income.Sub(expense),
 	}, nil
 }
-func (u *useCase) SummaryBudgetUsageByMonthYear(ctx context.Context, month, year int) (data []views.SummaryBudgetUtilization, err error) {
-	data, err = u.budgetMovementGateway.SummaryBudgetUsageByMonthYear(ctx, month, year)
-	return
+
+// InstallmentsSummary retorna as parcelas restantes e o valor futuro já comprometido
+func (u *useCase) InstallmentsSummary(ctx Context) (views.InstallmentsSummary, error) {
+	expenses, err := u.installmentGateway.SummaryCommitted(ctx)
+	if err != nil {
+		return views.InstallmentsSummary{}, err
+	}
+
+	summary := views.InstallmentsSummary{Expenses: expenses}
+	for _, expense := range expenses {
+		summary.RemainingInstallments += expense.Remaining
+		summary.CommittedAmount = summary.CommittedAmount.Add(expense.Committed)
+	}
+	return summary, nil
 }
+
+// TagBreakdown soma as ocorrências de receitas e despesas do mês por tag; lançamentos com várias tags contam em cada uma
+func (u *useCase) TagBreakdown(ctx Context, month, year int) ([]views.TagBreakdown, error) {
+	from, to := models.MonthRange(year, time.Month(month))
+
+	expenses, err := u.expenseGateway.ListActiveBetween(ctx, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	incomes, err := u.incomeGateway.ListActiveBetween(ctx, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	totals := map[string]*views.TagBreakdown{}
+	breakdown := func(tag string) *views.TagBreakdown {
+		if totals[tag] == nil {
+			totals[tag] = &views.TagBreakdown{Tag: tag}
+		}
+		return totals[tag]
+	}
+
+	for _, expense := range expenses {
+		var amount money.Money
+		for _, occurrence := range models.NewExpenseSchedule(expense).Occurrences(from, to) {
+			amount = amount.Add(occurrence.Amount)
+		}
+		for _, tag := range expense.Tags() {
+			breakdown(tag).TotalExpense = breakdown(tag).TotalExpense.Add(amount)
+		}
+	}
+
+	for _, income := range incomes {
+		var amount money.Money
+		for _, occurrence := range models.NewIncomeSchedule(income).Occurrences(from, to) {
+			amount = amount.Add(occurrence.Amount)
+		}
+		for _, tag := range income.Tags() {
+			breakdown(tag).TotalIncome = breakdown(tag).TotalIncome.Add(amount)
+		}
+	}
+
+	data := make([]views.TagBreakdown, 0, len(totals))
+	for _, total := range totals {
+		total.Balance = total.TotalIncome.Sub(total.TotalExpense)
+		data = append(data, *total)
+	}
+	sort.Slice(data, func(i, j int) bool {
+		return data[i].Tag < data[j].Tag
+	})
+	return data, nil
+}
+
 func NewDashBoardUseCase(
 	expenseGateway ExpenseGateway,
 	incomeGateway IncomeGateway,
 	budgetMovement BudgetMovementGateway,
+	installmentGateway InstallmentGateway,
 ) UseCase {
 	return &useCase{
 		expenseGateway:        expenseGateway,
 		incomeGateway:         incomeGateway,
 		budgetMovementGateway: budgetMovement,
+		installmentGateway:    installmentGateway,
 	}
 }
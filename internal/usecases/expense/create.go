@@ -3,8 +3,10 @@ package expense
 import (
 	"context"
 	"financial-backend/internal/dtos"
+	"financial-backend/internal/mappers"
 	"financial-backend/internal/models"
 	"financial-backend/internal/models/events"
+	"financial-backend/pkg/money"
 	"fmt"
 	"time"
 
@@ -12,21 +14,28 @@ import (
 )
 
 func (uc *useCase) Create(ctx context.Context, input *dtos.ExpenseDTO) (*dtos.ExpenseResponse, error) {
-	var endDate *time.Time
-	var startDate time.Time
-
-	startDate = input.StartDate
-	endDate = input.EndDate
+	startDate := input.StartDate
+	endDate := input.EndDate
+	dueDay := input.DueDay
 
 	if input.Method == string(models.ExpenseMethodCreditCard) {
-		if startDate.Day() > uc.defaultDueDate {
-			startDate = startDate.AddDate(0, 1, -startDate.Day()+uc.defaultDueDate)
-		} else {
-			startDate = startDate.AddDate(0, 0, uc.defaultDueDate-startDate.Day())
+		card, err := uc.findCreditCard(ctx, input.CardID)
+		if err != nil {
+			return nil, err
+		}
+
+		startDate, dueDay = uc.creditCardStartDate(card, input.StartDate)
+		if input.Installments != nil {
+			endDate = lastInstallmentDate(startDate, *input.Installments)
 		}
+	}
 
-		endDate = new(time.Time)
-		*endDate = startDate.AddDate(0, *input.Installments-1, 0)
+	if err := uc.validateCategory(ctx, input.CategoryID); err != nil {
+		return nil, err
+	}
+
+	if err := uc.validateAllocations(ctx, input.Allocations); err != nil {
+		return nil, err
 	}
 
 	expense, err := models.NewExpense(
@@ -35,12 +44,17 @@ func (uc *useCase) Create(ctx context.Context, input *dtos.ExpenseDTO) (*dtos.Ex
 		input.Amount,
 		input.Type,
 		input.BudgetID,
+		input.CardID,
+		input.CategoryID,
 		input.Recurrency,
+		input.Rrule,
 		input.Method,
 		input.Installments,
-		input.DueDay,
+		dueDay,
 		startDate,
 		endDate,
+		input.Tags,
+		mappers.ToExpenseAllocationModels(input.Allocations),
 		nil,
 	)
 
@@ -48,6 +62,14 @@ func (uc *useCase) Create(ctx context.Context, input *dtos.ExpenseDTO) (*dtos.Ex
 		return nil, err
 	}
 
+	if input.Split != nil {
+		split, err := uc.buildSplit(ctx, input.Amount, input.Split)
+		if err != nil {
+			return nil, err
+		}
+		expense = expense.WithSplit(split)
+	}
+
 	if err := uc.expenseGateway.Create(ctx, expense); err != nil {
 		return nil, fmt.Errorf("erro ao criar despesa: %v", err)
 	}
@@ -59,3 +81,73 @@ func (uc *useCase) Create(ctx context.Context, input *dtos.ExpenseDTO) (*dtos.Ex
 
 	return uc.toExpenseResponse(expense), nil
 }
+
+// findCreditCard busca o cartão da despesa; retorna nil quando nenhum cartão foi informado
+func (uc *useCase) findCreditCard(ctx context.Context, cardId *string) (models.CreditCard, error) {
+	if cardId == nil {
+		return nil, nil
+	}
+
+	card, err := uc.creditCardGateway.Get(ctx, *cardId)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar cartão: %v", err)
+	}
+	return card, nil
+}
+
+// validateCategory garante que a categoria informada existe
+func (uc *useCase) validateCategory(ctx context.Context, categoryId *string) error {
+	if categoryId == nil {
+		return nil
+	}
+
+	if _, err := uc.categoryGateway.Get(ctx, *categoryId); err != nil {
+		return fmt.Errorf("categoria não encontrada: %v", err)
+	}
+	return nil
+}
+
+// validateAllocations garante que os orçamentos das alocações existem
+func (uc *useCase) validateAllocations(ctx context.Context, allocations []dtos.ExpenseAllocationDTO) error {
+	for _, allocation := range allocations {
+		if _, err := uc.budgetGateway.Get(ctx, allocation.BudgetID); err != nil {
+			return fmt.Errorf("orçamento da alocação não encontrado: %v", err)
+		}
+	}
+	return nil
+}
+
+// buildSplit valida os membros da divisão e calcula a parte de cada um sobre amount
+func (uc *useCase) buildSplit(ctx context.Context, amount money.Money, input *dtos.ExpenseSplitDTO) (*models.ExpenseSplit, error) {
+	split, err := models.NewExpenseSplit(amount, input.PaidBy, input.Type, mappers.ToExpenseShareModels(input.Shares))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, memberId := range split.MemberIds() {
+		if _, err := uc.memberGateway.Get(ctx, memberId); err != nil {
+			return nil, fmt.Errorf("membro da divisão não encontrado: %v", err)
+		}
+	}
+	return split, nil
+}
+
+// creditCardStartDate retorna o vencimento da fatura em que a compra entra e o dia de vencimento usado.
+// Sem cartão, usa o vencimento padrão (DEFAULT_DUE_DATE): compras depois dele vão para o mês seguinte.
+func (uc *useCase) creditCardStartDate(card models.CreditCard, purchaseDate time.Time) (time.Time, int) {
+	if card != nil {
+		return card.StatementDueDate(purchaseDate), card.DueDay()
+	}
+
+	month := time.Date(purchaseDate.Year(), purchaseDate.Month(), 1, 0, 0, 0, 0, time.UTC)
+	if purchaseDate.Day() > uc.defaultDueDate {
+		month = month.AddDate(0, 1, 0)
+	}
+	return models.DayInMonth(month.Year(), month.Month(), uc.defaultDueDate), uc.defaultDueDate
+}
+
+// lastInstallmentDate calcula o vencimento da última parcela
+func lastInstallmentDate(startDate time.Time, installments int) *time.Time {
+	endDate := startDate.AddDate(0, installments-1, 0)
+	return &endDate
+}
@@ -0,0 +1,24 @@
+package expense
+
+import (
+	"context"
+	"financial-backend/internal/dtos"
+	"financial-backend/internal/mappers"
+	"financial-backend/internal/models"
+	"fmt"
+)
+
+func (uc *useCase) Occurrences(ctx context.Context, id string, params *dtos.OccurrenceParams) ([]dtos.OccurrenceResponse, error) {
+	from, to, err := params.Range()
+	if err != nil {
+		return nil, err
+	}
+
+	expense, err := uc.expenseGateway.Get(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar despesa: %v", err)
+	}
+
+	schedule := models.NewExpenseSchedule(expense)
+	return mappers.ToOccurrenceResponses(schedule, schedule.Occurrences(from, to)), nil
+}
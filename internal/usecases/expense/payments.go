@@ -0,0 +1,129 @@
+package expense
+
+import (
+	"context"
+	"financial-backend/internal/dtos"
+	"financial-backend/internal/mappers"
+	"financial-backend/internal/models"
+	"financial-backend/internal/models/events"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// overdueLookbackMonths é quantos meses para trás são verificados quando a busca não informa o início
+const overdueLookbackMonths = 3
+
+func (uc *useCase) RegisterPayment(ctx context.Context, id string, input *dtos.CreateExpensePaymentRequest) (*dtos.ExpensePaymentResponse, error) {
+	expense, err := uc.expenseGateway.Get(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar despesa: %v", err)
+	}
+
+	if !models.TracksPayments(expense) {
+		return nil, fmt.Errorf("pagamentos de compras no cartão ou parceladas são registrados pela fatura ou pelas parcelas")
+	}
+
+	occurrences := models.NewExpenseSchedule(expense).Occurrences(input.OccurrenceDate, input.OccurrenceDate)
+	if len(occurrences) == 0 {
+		return nil, fmt.Errorf("a despesa não possui ocorrência em %s", input.OccurrenceDate.Format(time.DateOnly))
+	}
+
+	amount := occurrences[0].Amount
+	if input.Amount != nil {
+		amount = *input.Amount
+	}
+
+	paidAt := time.Now()
+	if input.PaidAt != nil {
+		paidAt = *input.PaidAt
+	}
+
+	payment, err := models.NewExpensePayment(uuid.New().String(), expense.Id(), occurrences[0].Date, amount, paidAt, input.Note)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.expenseGateway.CreatePayment(ctx, payment); err != nil {
+		return nil, fmt.Errorf("erro ao registrar pagamento: %v", err)
+	}
+
+	response := mappers.ToExpensePaymentResponse(payment)
+	return &response, nil
+}
+
+func (uc *useCase) Payments(ctx context.Context, id string) ([]dtos.ExpensePaymentResponse, error) {
+	payments, err := uc.expenseGateway.ListPayments(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]dtos.ExpensePaymentResponse, len(payments))
+	for i, payment := range payments {
+		responses[i] = mappers.ToExpensePaymentResponse(payment)
+	}
+	return responses, nil
+}
+
+func (uc *useCase) Overdue(ctx context.Context, params *dtos.OverdueParams) ([]dtos.OverdueExpenseResponse, error) {
+	today := time.Now()
+	from := params.From
+	if from.IsZero() {
+		from = time.Date(today.Year(), today.Month()-overdueLookbackMonths, 1, 0, 0, 0, 0, time.UTC)
+	}
+
+	overdue, err := uc.findOverdue(ctx, from, today)
+	if err != nil {
+		return nil, err
+	}
+
+	today, _ = time.Parse(time.DateOnly, today.Format(time.DateOnly))
+	responses := make([]dtos.OverdueExpenseResponse, len(overdue))
+	for i, occurrence := range overdue {
+		responses[i] = mappers.ToOverdueExpenseResponse(occurrence, today)
+	}
+	return responses, nil
+}
+
+// CheckOverdue publica ExpenseOverdue para as ocorrências vencidas desde since e retorna quantas foram notificadas.
+// Cada ocorrência é notificada uma única vez, mesmo que apareça em verificações seguidas ou depois de um reinício.
+func (uc *useCase) CheckOverdue(ctx context.Context, since time.Time) (int, error) {
+	overdue, err := uc.findOverdue(ctx, since, time.Now())
+	if err != nil {
+		return 0, err
+	}
+
+	notified := 0
+	for _, occurrence := range overdue {
+		created, err := uc.expenseGateway.MarkOverdueNotified(ctx, occurrence.Expense.Id(), occurrence.Occurrence.Date)
+		if err != nil {
+			return notified, err
+		}
+		if !created {
+			continue
+		}
+
+		uc.eventPublisher.Publish(&events.ExpenseOverdueEvent{
+			Expense:    occurrence.Expense,
+			Occurrence: occurrence.Occurrence,
+			Context:    ctx,
+		})
+		notified++
+	}
+	return notified, nil
+}
+
+func (uc *useCase) findOverdue(ctx context.Context, from, today time.Time) ([]models.OverdueOccurrence, error) {
+	expenses, err := uc.expenseGateway.ListActiveBetween(ctx, from, today)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao listar despesas: %v", err)
+	}
+
+	payments, err := uc.expenseGateway.ListPaymentsBetween(ctx, from, today)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao listar pagamentos: %v", err)
+	}
+
+	return models.FindOverdueOccurrences(expenses, payments, from, today), nil
+}
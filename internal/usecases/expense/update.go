@@ -0,0 +1,203 @@
+package expense
+
+import (
+	"context"
+	"financial-backend/internal/dtos"
+	"financial-backend/internal/mappers"
+	"financial-backend/internal/models"
+	"financial-backend/internal/models/events"
+	"financial-backend/pkg/money"
+	"fmt"
+)
+
+func (uc *useCase) Update(ctx context.Context, id string, input *dtos.UpdateExpenseRequest) (*dtos.ExpenseResponse, error) {
+	current, err := uc.expenseGateway.Get(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar despesa: %v", err)
+	}
+
+	description := current.Description()
+	if input.Description != nil {
+		description = *input.Description
+	}
+
+	amount := current.Amount()
+	if input.Amount != nil {
+		amount = *input.Amount
+	}
+
+	expenseType := string(current.Type())
+	if input.Type != nil {
+		expenseType = *input.Type
+	}
+
+	recurrency := (*string)(current.Recurrency())
+	rrule := current.Rrule()
+	if input.Recurrency != nil {
+		// a rrule só vale para a recorrência em que foi definida
+		if recurrency == nil || *recurrency != *input.Recurrency {
+			rrule = nil
+		}
+		recurrency = input.Recurrency
+	}
+
+	if input.Rrule != nil {
+		rrule = input.Rrule
+		if *rrule == "" {
+			rrule = nil
+		}
+	}
+
+	method := string(current.Method())
+	if input.Method != nil {
+		method = *input.Method
+	}
+
+	installments := current.Installments()
+	if input.Installments != nil {
+		installments = input.Installments
+	}
+
+	dueDay := current.DueDay()
+	if input.DueDate != nil {
+		dueDay = input.DueDate.Day()
+	}
+	if input.DueDay != nil {
+		dueDay = *input.DueDay
+	}
+
+	startDate := current.StartDate()
+	endDate := current.EndDate()
+	if input.EndDate != nil {
+		endDate = input.EndDate
+	}
+
+	cardId := current.CardId()
+	if input.CardID != nil {
+		cardId = input.CardID
+	}
+
+	if method == string(models.ExpenseMethodCreditCard) {
+		if input.CardID != nil && input.StartDate == nil {
+			return nil, fmt.Errorf("ao trocar o cartão é necessário informar a data da compra")
+		}
+
+		if input.StartDate != nil {
+			card, err := uc.findCreditCard(ctx, cardId)
+			if err != nil {
+				return nil, err
+			}
+			startDate, dueDay = uc.creditCardStartDate(card, *input.StartDate)
+		}
+
+		if installments != nil && (input.StartDate != nil || input.Installments != nil) {
+			endDate = lastInstallmentDate(startDate, *installments)
+		}
+	} else {
+		cardId = nil
+		if input.StartDate != nil {
+			startDate = *input.StartDate
+		}
+	}
+
+	categoryId := current.CategoryId()
+	if input.CategoryID != nil {
+		categoryId = input.CategoryID
+		if *categoryId == "" {
+			categoryId = nil
+		}
+		if err := uc.validateCategory(ctx, categoryId); err != nil {
+			return nil, err
+		}
+	}
+
+	tags := current.Tags()
+	if input.Tags != nil {
+		tags = input.Tags
+	}
+
+	allocations := current.Allocations()
+	if input.Allocations != nil {
+		if err := uc.validateAllocations(ctx, input.Allocations); err != nil {
+			return nil, err
+		}
+		allocations = mappers.ToExpenseAllocationModels(input.Allocations)
+	}
+
+	budgetId := current.BudgetId()
+	var budget *models.Budget
+	if input.BudgetID != nil {
+		// com alocações, o orçamento principal é sempre o da primeira alocação
+		if len(allocations) > 0 && *input.BudgetID != allocations[0].BudgetId {
+			return nil, fmt.Errorf("budget_id deve ser o orçamento da primeira alocação (%s); altere as alocações para trocar o orçamento", allocations[0].BudgetId)
+		}
+
+		budgetId = nil
+		if *input.BudgetID != "" {
+			foundBudget, err := uc.budgetGateway.Get(ctx, *input.BudgetID)
+			if err != nil {
+				return nil, err
+			}
+			budgetId = input.BudgetID
+			budget = &foundBudget
+		}
+	}
+
+	expense, err := models.NewExpense(
+		current.Id(),
+		description,
+		amount,
+		expenseType,
+		budgetId,
+		cardId,
+		categoryId,
+		recurrency,
+		rrule,
+		method,
+		installments,
+		dueDay,
+		startDate,
+		endDate,
+		tags,
+		allocations,
+		budget,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	split, err := uc.updatedSplit(ctx, current, amount, input.Split)
+	if err != nil {
+		return nil, err
+	}
+	expense = expense.WithSplit(split)
+
+	if err := uc.expenseGateway.Update(ctx, expense); err != nil {
+		return nil, fmt.Errorf("erro ao atualizar despesa: %v", err)
+	}
+
+	uc.eventPublisher.Publish(&events.ExpenseUpdatedEvent{
+		Previous: current,
+		Expense:  expense,
+		Context:  ctx,
+	})
+
+	return uc.toExpenseResponse(expense), nil
+}
+
+// updatedSplit aplica a nova divisão informada ou recalcula a atual sobre o novo valor da despesa
+func (uc *useCase) updatedSplit(ctx context.Context, current models.Expense, amount money.Money, input *dtos.ExpenseSplitDTO) (*models.ExpenseSplit, error) {
+	if input != nil {
+		if input.PaidBy == "" {
+			return nil, nil
+		}
+		return uc.buildSplit(ctx, amount, input)
+	}
+
+	split := current.Split()
+	if split == nil || amount == current.Amount() {
+		return split, nil
+	}
+	return models.NewExpenseSplit(amount, split.PaidBy, string(split.Type), split.Shares)
+}
@@ -4,43 +4,103 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"time"
 
 	"financial-backend/internal/dtos"
 	"financial-backend/internal/gateways"
+	"financial-backend/internal/mappers"
 	"financial-backend/internal/models"
+	"financial-backend/internal/models/events"
 	"financial-backend/pkg/config"
 )
 
 type useCase struct {
-	expenseGateway gateways.ExpenseGateway
-	budgetGateway  gateways.BudgetGateway
-	eventPublisher config.Publisher
-	defaultDueDate int
+	expenseGateway    gateways.ExpenseGateway
+	budgetGateway     gateways.BudgetGateway
+	creditCardGateway gateways.CreditCardGateway
+	categoryGateway   gateways.CategoryGateway
+	memberGateway     gateways.MemberGateway
+	eventPublisher    config.Publisher
+	defaultDueDate    int
 }
 
 type UseCase interface {
 	Create(ctx context.Context, input *dtos.ExpenseDTO) (*dtos.ExpenseResponse, error)
-	Delete(ctx context.Context, id string) error
+	Update(ctx context.Context, id string, input *dtos.UpdateExpenseRequest) (*dtos.ExpenseResponse, error)
+	Delete(ctx context.Context, id string, params *dtos.DeleteExpenseParams) error
 	FindByID(ctx context.Context, id string) (*dtos.ExpenseResponse, error)
 	List(ctx context.Context, input *dtos.ListExpensesRequest) (*models.Page[*dtos.ExpenseResponse], error)
+	Occurrences(ctx context.Context, id string, params *dtos.OccurrenceParams) ([]dtos.OccurrenceResponse, error)
+	RegisterPayment(ctx context.Context, id string, input *dtos.CreateExpensePaymentRequest) (*dtos.ExpensePaymentResponse, error)
+	Payments(ctx context.Context, id string) ([]dtos.ExpensePaymentResponse, error)
+	Overdue(ctx context.Context, params *dtos.OverdueParams) ([]dtos.OverdueExpenseResponse, error)
+	CheckOverdue(ctx context.Context, since time.Time) (int, error)
+	Restore(ctx context.Context, id string) (*dtos.ExpenseResponse, error)
 }
 
-func NewUseCase(expenseGateway gateways.ExpenseGateway, budgetGateway gateways.BudgetGateway, eventPublisher config.Publisher, defaultDueDate int) UseCase {
+func NewUseCase(
+	expenseGateway gateways.ExpenseGateway,
+	budgetGateway gateways.BudgetGateway,
+	creditCardGateway gateways.CreditCardGateway,
+	categoryGateway gateways.CategoryGateway,
+	memberGateway gateways.MemberGateway,
+	eventPublisher config.Publisher,
+	defaultDueDate int,
+) UseCase {
 	return &useCase{
-		expenseGateway: expenseGateway,
-		budgetGateway:  budgetGateway,
-		eventPublisher: eventPublisher,
-		defaultDueDate: defaultDueDate,
+		expenseGateway:    expenseGateway,
+		budgetGateway:     budgetGateway,
+		creditCardGateway: creditCardGateway,
+		categoryGateway:   categoryGateway,
+		memberGateway:     memberGateway,
+		eventPublisher:    eventPublisher,
+		defaultDueDate:    defaultDueDate,
 	}
 }
 
-func (uc *useCase) Delete(ctx context.Context, id string) error {
+func (uc *useCase) Delete(ctx context.Context, id string, params *dtos.DeleteExpenseParams) error {
+	strategy, err := models.NewMovementReversalStrategy(params.Strategy)
+	if err != nil {
+		return err
+	}
+
+	expense, err := uc.expenseGateway.Get(ctx, id)
+	if err != nil {
+		return fmt.Errorf("erro ao buscar despesa: %v", err)
+	}
+
 	if err := uc.expenseGateway.Delete(ctx, id); err != nil {
 		return fmt.Errorf("erro ao excluir despesa: %v", err)
 	}
+
+	uc.eventPublisher.Publish(&events.ExpenseDeletedEvent{
+		Expense:        expense,
+		Strategy:       strategy,
+		KeepPastMonths: params.KeepPastMonths,
+		Context:        ctx,
+	})
 	return nil
 }
 
+// Restore desfaz a exclusão da despesa e avisa os interessados para refazer as movimentações
+func (uc *useCase) Restore(ctx context.Context, id string) (*dtos.ExpenseResponse, error) {
+	if err := uc.expenseGateway.Restore(ctx, id); err != nil {
+		return nil, err
+	}
+
+	expense, err := uc.expenseGateway.Get(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar despesa: %v", err)
+	}
+
+	uc.eventPublisher.Publish(&events.ExpenseRestoredEvent{
+		Expense: expense,
+		Context: ctx,
+	})
+
+	return uc.toExpenseResponse(expense), nil
+}
+
 func (uc *useCase) FindByID(ctx context.Context, id string) (*dtos.ExpenseResponse, error) {
 	expense, err := uc.expenseGateway.Get(ctx, id)
 	if err != nil {
@@ -54,10 +114,12 @@ func (uc *useCase) List(ctx context.Context, request *dtos.ListExpensesRequest)
 		ctx,
 		request.Description,
 		request.Type,
-		request.Category,
+		request.CategoryID,
 		request.BudgetID,
 		request.Recurrency,
 		request.Method,
+		request.Tag,
+		request.IncludeDeleted,
 		models.PageRequest{
 			Limit: request.Limit,
 			Page:  request.Page,
@@ -102,13 +164,20 @@ func (uc *useCase) toExpenseResponse(expense models.Expense) *dtos.ExpenseRespon
 			Amount:       expense.Amount(),
 			Type:         string(expense.Type()),
 			BudgetID:     expense.BudgetId(),
+			CardID:       expense.CardId(),
+			CategoryID:   expense.CategoryId(),
 			Recurrency:   (*string)(expense.Recurrency()),
+			Rrule:        expense.Rrule(),
 			Method:       string(expense.Method()),
 			Installments: expense.Installments(),
 			DueDay:       expense.DueDay(),
 			StartDate:    expense.StartDate(),
 			EndDate:      expense.EndDate(),
+			Tags:         expense.Tags(),
+			Allocations:  mappers.ToExpenseAllocationDTOs(expense.Allocations()),
+			Split:        mappers.ToExpenseSplitDTO(expense.Split()),
 			Budget:       budget,
 		},
+		DeletedAt: expense.DeletedAt(),
 	}
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"financial-backend/internal/dtos"
 	"financial-backend/internal/gateways"
+	"financial-backend/internal/mappers"
 	"financial-backend/internal/models"
 	"fmt"
 	"math"
@@ -17,25 +18,38 @@ type UseCase interface {
 	Delete(ctx context.Context, id string) error
 	Get(ctx context.Context, id string) (*dtos.IncomeResponse, error)
 	List(ctx context.Context, params dtos.ListIncomeParams) (*models.Page[*dtos.IncomeResponse], error)
+	Occurrences(ctx context.Context, id string, params *dtos.OccurrenceParams) ([]dtos.OccurrenceResponse, error)
+	Restore(ctx context.Context, id string) (*dtos.IncomeResponse, error)
 }
 
 type useCase struct {
-	gateway gateways.IncomeGateway
+	gateway         gateways.IncomeGateway
+	categoryGateway gateways.CategoryGateway
 }
 
-func NewUseCase(gateway gateways.IncomeGateway) UseCase {
-	return &useCase{gateway: gateway}
+func NewUseCase(gateway gateways.IncomeGateway, categoryGateway gateways.CategoryGateway) UseCase {
+	return &useCase{
+		gateway:         gateway,
+		categoryGateway: categoryGateway,
+	}
 }
 
 func (uc *useCase) Create(ctx context.Context, dto *dtos.CreateIncomeRequest) (*dtos.IncomeResponse, error) {
+	if err := uc.validateCategory(ctx, dto.CategoryID); err != nil {
+		return nil, err
+	}
+
 	income, err := models.NewIncome(
 		uuid.New().String(),
 		dto.Description,
 		dto.Amount,
 		models.IncomeType(dto.Type),
 		dto.DueDay,
+		dto.Rrule,
+		dto.CategoryID,
 		dto.StartDate,
 		dto.EndDate,
+		dto.Tags,
 	)
 
 	if err != nil {
@@ -50,21 +64,67 @@ func (uc *useCase) Create(ctx context.Context, dto *dtos.CreateIncomeRequest) (*
 }
 
 func (uc *useCase) Update(ctx context.Context, id string, dto *dtos.UpdateIncomeRequest) (*dtos.IncomeResponse, error) {
-	income, err := uc.gateway.Get(ctx, id)
+	current, err := uc.gateway.Get(ctx, id)
 	if err != nil {
 		return nil, err
 	}
 
-	// if dto.Description != nil {
-	// 	income.SetDescription(*dto.Description)
-	// }
-	// if dto.Amount != nil {
-	// 	income.SetAmount(*dto.Amount)
-	// }
-	// if dto.Date != nil {
-	// 	income.SetDate(dto.Date)
-	// }
-	// income.SetUpdatedAt(time.Now())
+	description := current.Description()
+	if dto.Description != nil {
+		description = *dto.Description
+	}
+
+	amount := current.Amount()
+	if dto.Amount != nil {
+		amount = *dto.Amount
+	}
+
+	incomeType := current.Type()
+	if dto.Type != nil {
+		incomeType = models.IncomeType(*dto.Type)
+	}
+
+	dueDay := current.DueDay()
+	if dto.DueDay != nil {
+		dueDay = *dto.DueDay
+	}
+
+	endDate := current.EndDate()
+	if dto.EndDate != nil {
+		endDate = dto.EndDate
+	}
+
+	tags := current.Tags()
+	if dto.Tags != nil {
+		tags = dto.Tags
+	}
+
+	categoryId := current.CategoryId()
+	if dto.CategoryID != nil {
+		categoryId = dto.CategoryID
+		if *categoryId == "" {
+			categoryId = nil
+		}
+		if err := uc.validateCategory(ctx, categoryId); err != nil {
+			return nil, err
+		}
+	}
+
+	income, err := models.NewIncome(
+		current.ID(),
+		description,
+		amount,
+		incomeType,
+		dueDay,
+		current.Rrule(),
+		categoryId,
+		current.StartDate(),
+		endDate,
+		tags,
+	)
+	if err != nil {
+		return nil, err
+	}
 
 	if err := uc.gateway.Update(ctx, income); err != nil {
 		return nil, err
@@ -77,6 +137,14 @@ func (uc *useCase) Delete(ctx context.Context, id string) error {
 	return uc.gateway.Delete(ctx, id)
 }
 
+// Restore desfaz a exclusão da receita
+func (uc *useCase) Restore(ctx context.Context, id string) (*dtos.IncomeResponse, error) {
+	if err := uc.gateway.Restore(ctx, id); err != nil {
+		return nil, err
+	}
+	return uc.Get(ctx, id)
+}
+
 func (uc *useCase) Get(ctx context.Context, id string) (*dtos.IncomeResponse, error) {
 	income, err := uc.gateway.Get(ctx, id)
 	if err != nil {
@@ -87,7 +155,7 @@ func (uc *useCase) Get(ctx context.Context, id string) (*dtos.IncomeResponse, er
 
 func (uc *useCase) List(ctx context.Context, params dtos.ListIncomeParams) (*models.Page[*dtos.IncomeResponse], error) {
 	fmt.Printf("params %v", params)
-	incomes, count, err := uc.gateway.List(ctx, params.Description, params.Type, models.PageRequest{
+	incomes, count, err := uc.gateway.List(ctx, params.Description, params.Type, params.CategoryID, params.Tag, params.IncludeDeleted, models.PageRequest{
 		Limit: params.Limit,
 		Page:  params.Page,
 	})
@@ -108,6 +176,33 @@ func (uc *useCase) List(ctx context.Context, params dtos.ListIncomeParams) (*mod
 	}, nil
 }
 
+func (uc *useCase) Occurrences(ctx context.Context, id string, params *dtos.OccurrenceParams) ([]dtos.OccurrenceResponse, error) {
+	from, to, err := params.Range()
+	if err != nil {
+		return nil, err
+	}
+
+	income, err := uc.gateway.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule := models.NewIncomeSchedule(income)
+	return mappers.ToOccurrenceResponses(schedule, schedule.Occurrences(from, to)), nil
+}
+
+// validateCategory garante que a categoria informada existe
+func (uc *useCase) validateCategory(ctx context.Context, categoryId *string) error {
+	if categoryId == nil {
+		return nil
+	}
+
+	if _, err := uc.categoryGateway.Get(ctx, *categoryId); err != nil {
+		return fmt.Errorf("categoria não encontrada: %v", err)
+	}
+	return nil
+}
+
 func (uc *useCase) toResponse(income models.Income) *dtos.IncomeResponse {
 	if income == nil {
 		return nil
@@ -119,9 +214,13 @@ func (uc *useCase) toResponse(income models.Income) *dtos.IncomeResponse {
 		Amount:      income.Amount(),
 		Type:        string(income.Type()),
 		DueDay:      income.DueDay(),
+		Rrule:       income.Rrule(),
+		CategoryID:  income.CategoryId(),
 		StartDate:   income.StartDate(),
 		EndDate:     income.EndDate(),
+		Tags:        income.Tags(),
 		CreatedAt:   income.CreatedAt(),
 		UpdatedAt:   income.UpdatedAt(),
+		DeletedAt:   income.DeletedAt(),
 	}
 }
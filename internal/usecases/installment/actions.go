@@ -0,0 +1,78 @@
+package installment
+
+import (
+	"context"
+	"financial-backend/internal/dtos"
+	"financial-backend/internal/mappers"
+	"fmt"
+	"time"
+)
+
+func (uc *useCase) Pay(ctx context.Context, id string, dto *dtos.PayInstallmentRequest) (dtos.ExpenseInstallmentResponse, error) {
+	installment, err := uc.gateway.Get(ctx, id)
+	if err != nil {
+		return dtos.ExpenseInstallmentResponse{}, err
+	}
+
+	paidAt := time.Now()
+	if dto.PaidAt != nil {
+		paidAt = *dto.PaidAt
+	}
+
+	if err := installment.Pay(paidAt); err != nil {
+		return dtos.ExpenseInstallmentResponse{}, err
+	}
+
+	if err := uc.gateway.Update(ctx, installment); err != nil {
+		return dtos.ExpenseInstallmentResponse{}, fmt.Errorf("erro ao pagar parcela: %v", err)
+	}
+
+	return mappers.ToExpenseInstallmentResponse(installment), nil
+}
+
+// Reschedule altera o vencimento da parcela e leva as movimentações do orçamento ligadas a ela para o novo vencimento
+func (uc *useCase) Reschedule(ctx context.Context, id string, dto *dtos.RescheduleInstallmentRequest) (dtos.ExpenseInstallmentResponse, error) {
+	installment, err := uc.gateway.Get(ctx, id)
+	if err != nil {
+		return dtos.ExpenseInstallmentResponse{}, err
+	}
+
+	previous := installment.DueDate()
+	if err := installment.Reschedule(dto.DueDate); err != nil {
+		return dtos.ExpenseInstallmentResponse{}, err
+	}
+
+	if err := uc.gateway.Update(ctx, installment); err != nil {
+		return dtos.ExpenseInstallmentResponse{}, fmt.Errorf("erro ao reagendar parcela: %v", err)
+	}
+
+	if !previous.Equal(dto.DueDate) {
+		if err := uc.budgetMovementGateway.MoveByInstallment(ctx, installment.ID(), dto.DueDate); err != nil {
+			return dtos.ExpenseInstallmentResponse{}, err
+		}
+	}
+
+	return mappers.ToExpenseInstallmentResponse(installment), nil
+}
+
+// Cancel cancela a parcela e remove as movimentações do orçamento ligadas a ela
+func (uc *useCase) Cancel(ctx context.Context, id string) (dtos.ExpenseInstallmentResponse, error) {
+	installment, err := uc.gateway.Get(ctx, id)
+	if err != nil {
+		return dtos.ExpenseInstallmentResponse{}, err
+	}
+
+	if err := installment.Cancel(); err != nil {
+		return dtos.ExpenseInstallmentResponse{}, err
+	}
+
+	if err := uc.gateway.Update(ctx, installment); err != nil {
+		return dtos.ExpenseInstallmentResponse{}, fmt.Errorf("erro ao cancelar parcela: %v", err)
+	}
+
+	if err := uc.budgetMovementGateway.DeleteByInstallment(ctx, installment.ID()); err != nil {
+		return dtos.ExpenseInstallmentResponse{}, err
+	}
+
+	return mappers.ToExpenseInstallmentResponse(installment), nil
+}
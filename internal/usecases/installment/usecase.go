@@ -0,0 +1,159 @@
+package installment
+
+import (
+	"context"
+	"financial-backend/internal/dtos"
+	"financial-backend/internal/gateways"
+	"financial-backend/internal/mappers"
+	"financial-backend/internal/models"
+
+	"github.com/google/uuid"
+)
+
+type UseCase interface {
+	GenerateForExpense(ctx context.Context, expense models.Expense) error
+	SyncForExpense(ctx context.Context, expense models.Expense) error
+	CancelForExpense(ctx context.Context, expenseId string) error
+	RestoreForExpense(ctx context.Context, expense models.Expense) error
+	ListByExpense(ctx context.Context, expenseId string) ([]dtos.ExpenseInstallmentResponse, error)
+	Pay(ctx context.Context, id string, dto *dtos.PayInstallmentRequest) (dtos.ExpenseInstallmentResponse, error)
+	Reschedule(ctx context.Context, id string, dto *dtos.RescheduleInstallmentRequest) (dtos.ExpenseInstallmentResponse, error)
+	Cancel(ctx context.Context, id string) (dtos.ExpenseInstallmentResponse, error)
+	Backfill(ctx context.Context) (int, error)
+}
+
+type useCase struct {
+	gateway               gateways.InstallmentGateway
+	expenseGateway        gateways.ExpenseGateway
+	budgetMovementGateway gateways.BudgetMovementGateway
+}
+
+func NewUseCase(gateway gateways.InstallmentGateway, expenseGateway gateways.ExpenseGateway, budgetMovementGateway gateways.BudgetMovementGateway) UseCase {
+	return &useCase{
+		gateway:               gateway,
+		expenseGateway:        expenseGateway,
+		budgetMovementGateway: budgetMovementGateway,
+	}
+}
+
+// GenerateForExpense grava as parcelas de uma despesa parcelada recém-criada
+func (uc *useCase) GenerateForExpense(ctx context.Context, expense models.Expense) error {
+	return uc.gateway.CreateAll(ctx, models.BuildExpenseInstallments(expense, newId))
+}
+
+// SyncForExpense regera as parcelas em aberto de uma despesa alterada; parcelas pagas ou canceladas são mantidas.
+// As parcelas em aberto reagendadas à mão mantêm o vencimento e só recebem o novo valor; as que deixaram de existir
+// na nova quantidade de parcelas são canceladas.
+func (uc *useCase) SyncForExpense(ctx context.Context, expense models.Expense) error {
+	current, err := uc.gateway.ListByExpense(ctx, expense.Id())
+	if err != nil {
+		return err
+	}
+
+	schedule := models.BuildExpenseInstallments(expense, newId)
+	built := map[int]models.ExpenseInstallment{}
+	for _, installment := range schedule {
+		built[installment.Number()] = installment
+	}
+
+	kept := map[int]bool{}
+	for _, installment := range current {
+		if installment.Open() && !installment.Rescheduled() {
+			continue
+		}
+		kept[installment.Number()] = true
+
+		if !installment.Open() {
+			continue
+		}
+		if err := uc.syncRescheduled(ctx, installment, built[installment.Number()]); err != nil {
+			return err
+		}
+	}
+
+	if err := uc.gateway.DeleteOpenByExpense(ctx, expense.Id()); err != nil {
+		return err
+	}
+
+	var installments []models.ExpenseInstallment
+	for _, installment := range schedule {
+		if !kept[installment.Number()] {
+			installments = append(installments, installment)
+		}
+	}
+	return uc.gateway.CreateAll(ctx, installments)
+}
+
+// syncRescheduled atualiza o valor de uma parcela reagendada pela parcela equivalente da agenda atual da despesa,
+// ou a cancela quando a agenda não tem mais essa parcela
+func (uc *useCase) syncRescheduled(ctx context.Context, installment, built models.ExpenseInstallment) error {
+	if built == nil {
+		if err := installment.Cancel(); err != nil {
+			return err
+		}
+		return uc.gateway.Update(ctx, installment)
+	}
+
+	if built.Amount() == installment.Amount() {
+		return nil
+	}
+	return uc.gateway.Update(ctx, models.NewExpenseInstallment(
+		installment.ID(),
+		installment.ExpenseId(),
+		installment.Number(),
+		installment.DueDate(),
+		built.Amount(),
+		installment.Status(),
+		installment.PaidAt(),
+	).WithRescheduled(true))
+}
+
+// Backfill grava as parcelas das despesas parceladas criadas antes das parcelas existirem e retorna quantas despesas
+// foram preenchidas. Despesas que já têm parcelas são ignoradas, então pode rodar a cada inicialização.
+func (uc *useCase) Backfill(ctx context.Context) (int, error) {
+	ids, err := uc.gateway.ExpensesWithoutInstallments(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	for i, id := range ids {
+		expense, err := uc.expenseGateway.Get(ctx, id)
+		if err != nil {
+			return i, err
+		}
+		if err := uc.GenerateForExpense(ctx, expense); err != nil {
+			return i, err
+		}
+	}
+	return len(ids), nil
+}
+
+// CancelForExpense cancela as parcelas em aberto de uma despesa excluída
+func (uc *useCase) CancelForExpense(ctx context.Context, expenseId string) error {
+	return uc.gateway.CancelOpenByExpense(ctx, expenseId)
+}
+
+// RestoreForExpense reabre as parcelas canceladas pela exclusão de uma despesa restaurada e as sincroniza com ela
+func (uc *useCase) RestoreForExpense(ctx context.Context, expense models.Expense) error {
+	if err := uc.gateway.ReopenCancelledWithExpense(ctx, expense.Id()); err != nil {
+		return err
+	}
+	return uc.SyncForExpense(ctx, expense)
+}
+
+func (uc *useCase) ListByExpense(ctx context.Context, expenseId string) ([]dtos.ExpenseInstallmentResponse, error) {
+	installments, err := uc.gateway.ListByExpense(ctx, expenseId)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]dtos.ExpenseInstallmentResponse, len(installments))
+	for i, installment := range installments {
+		responses[i] = mappers.ToExpenseInstallmentResponse(installment)
+	}
+	return responses, nil
+}
+
+func newId() string {
+	return uuid.New().String()
+}
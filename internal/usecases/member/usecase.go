@@ -0,0 +1,92 @@
+package member
+
+import (
+	"context"
+	"errors"
+	"financial-backend/internal/dtos"
+	"financial-backend/internal/gateways"
+	"financial-backend/internal/mappers"
+	"financial-backend/internal/models"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+type UseCase interface {
+	Create(ctx context.Context, dto *dtos.MemberRequest) (dtos.MemberResponse, error)
+	Update(ctx context.Context, id string, dto *dtos.MemberRequest) (dtos.MemberResponse, error)
+	Delete(ctx context.Context, id string) error
+	Get(ctx context.Context, id string) (dtos.MemberResponse, error)
+	List(ctx context.Context) ([]dtos.MemberResponse, error)
+}
+
+type useCase struct {
+	gateway gateways.MemberGateway
+}
+
+func NewUseCase(gateway gateways.MemberGateway) UseCase {
+	return &useCase{gateway: gateway}
+}
+
+func (uc *useCase) Create(ctx context.Context, dto *dtos.MemberRequest) (dtos.MemberResponse, error) {
+	member, err := models.NewMember(uuid.New().String(), dto.Name)
+	if err != nil {
+		return dtos.MemberResponse{}, err
+	}
+
+	if err := uc.gateway.Create(ctx, member); err != nil {
+		return dtos.MemberResponse{}, fmt.Errorf("erro ao criar membro: %v", err)
+	}
+
+	return mappers.ToMemberResponse(member), nil
+}
+
+func (uc *useCase) Update(ctx context.Context, id string, dto *dtos.MemberRequest) (dtos.MemberResponse, error) {
+	if _, err := uc.gateway.Get(ctx, id); err != nil {
+		return dtos.MemberResponse{}, err
+	}
+
+	member, err := models.NewMember(id, dto.Name)
+	if err != nil {
+		return dtos.MemberResponse{}, err
+	}
+
+	if err := uc.gateway.Update(ctx, member); err != nil {
+		return dtos.MemberResponse{}, fmt.Errorf("erro ao atualizar membro: %v", err)
+	}
+
+	return mappers.ToMemberResponse(member), nil
+}
+
+// Delete remove o membro apenas quando ele não participa de nenhuma despesa ou reembolso
+func (uc *useCase) Delete(ctx context.Context, id string) error {
+	usages, err := uc.gateway.CountUsages(ctx, id)
+	if err != nil {
+		return err
+	}
+	if usages > 0 {
+		return errors.New("membro participa de despesas compartilhadas e não pode ser excluído")
+	}
+	return uc.gateway.Delete(ctx, id)
+}
+
+func (uc *useCase) Get(ctx context.Context, id string) (dtos.MemberResponse, error) {
+	member, err := uc.gateway.Get(ctx, id)
+	if err != nil {
+		return dtos.MemberResponse{}, err
+	}
+	return mappers.ToMemberResponse(member), nil
+}
+
+func (uc *useCase) List(ctx context.Context) ([]dtos.MemberResponse, error) {
+	members, err := uc.gateway.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]dtos.MemberResponse, len(members))
+	for i, member := range members {
+		responses[i] = mappers.ToMemberResponse(member)
+	}
+	return responses, nil
+}
@@ -0,0 +1,140 @@
+package settlement
+
+import (
+	"context"
+	"errors"
+	"financial-backend/internal/dtos"
+	"financial-backend/internal/gateways"
+	"financial-backend/internal/mappers"
+	"financial-backend/internal/models"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type UseCase interface {
+	Summary(ctx context.Context, params *dtos.SettlementParams) (dtos.SettlementSummaryResponse, error)
+	SettleUp(ctx context.Context, input *dtos.SettleUpRequest) ([]dtos.SettlementResponse, error)
+	History(ctx context.Context) ([]dtos.SettlementResponse, error)
+}
+
+type useCase struct {
+	settlementGateway gateways.SettlementGateway
+	expenseGateway    gateways.ExpenseGateway
+	memberGateway     gateways.MemberGateway
+}
+
+func NewUseCase(
+	settlementGateway gateways.SettlementGateway,
+	expenseGateway gateways.ExpenseGateway,
+	memberGateway gateways.MemberGateway,
+) UseCase {
+	return &useCase{
+		settlementGateway: settlementGateway,
+		expenseGateway:    expenseGateway,
+		memberGateway:     memberGateway,
+	}
+}
+
+// Summary calcula o saldo de cada membro e quem deve a quem após a compensação
+func (uc *useCase) Summary(ctx context.Context, params *dtos.SettlementParams) (dtos.SettlementSummaryResponse, error) {
+	until := time.Now()
+	if params.Date != nil {
+		until = *params.Date
+	}
+
+	balances, err := uc.balances(ctx, until)
+	if err != nil {
+		return dtos.SettlementSummaryResponse{}, err
+	}
+
+	names, err := uc.memberNames(ctx)
+	if err != nil {
+		return dtos.SettlementSummaryResponse{}, err
+	}
+
+	return mappers.ToSettlementSummary(balances, models.NetTransfers(balances), names), nil
+}
+
+// SettleUp registra como reembolsos as transferências que quitam os saldos atuais
+func (uc *useCase) SettleUp(ctx context.Context, input *dtos.SettleUpRequest) ([]dtos.SettlementResponse, error) {
+	settledAt := time.Now()
+	if input.SettledAt != nil {
+		settledAt = *input.SettledAt
+	}
+
+	balances, err := uc.balances(ctx, settledAt)
+	if err != nil {
+		return nil, err
+	}
+
+	transfers := models.NetTransfers(balances)
+	if len(transfers) == 0 {
+		return nil, errors.New("não há saldos a acertar")
+	}
+
+	settlements := make([]models.Settlement, len(transfers))
+	for i, transfer := range transfers {
+		settlement, err := models.NewSettlement(uuid.New().String(), transfer.FromMemberId, transfer.ToMemberId, transfer.Amount, settledAt)
+		if err != nil {
+			return nil, err
+		}
+		settlements[i] = settlement
+	}
+
+	if err := uc.settlementGateway.CreateAll(ctx, settlements); err != nil {
+		return nil, fmt.Errorf("erro ao registrar reembolsos: %v", err)
+	}
+
+	return uc.toResponses(ctx, settlements)
+}
+
+// History lista os reembolsos já registrados, do mais recente para o mais antigo
+func (uc *useCase) History(ctx context.Context) ([]dtos.SettlementResponse, error) {
+	settlements, err := uc.settlementGateway.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return uc.toResponses(ctx, settlements)
+}
+
+func (uc *useCase) balances(ctx context.Context, until time.Time) ([]models.MemberBalance, error) {
+	expenses, err := uc.expenseGateway.ListShared(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	settlements, err := uc.settlementGateway.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return models.ComputeBalances(expenses, settlements, until), nil
+}
+
+func (uc *useCase) memberNames(ctx context.Context) (map[string]string, error) {
+	members, err := uc.memberGateway.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make(map[string]string, len(members))
+	for _, member := range members {
+		names[member.ID()] = member.Name()
+	}
+	return names, nil
+}
+
+func (uc *useCase) toResponses(ctx context.Context, settlements []models.Settlement) ([]dtos.SettlementResponse, error) {
+	names, err := uc.memberNames(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]dtos.SettlementResponse, len(settlements))
+	for i, settlement := range settlements {
+		responses[i] = mappers.ToSettlementResponse(settlement, names)
+	}
+	return responses, nil
+}
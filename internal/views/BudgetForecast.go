@@ -0,0 +1,23 @@
+package views
+
+import "financial-backend/pkg/money"
+
+// BudgetForecast é a projeção do gasto de um orçamento até o fim do período que contém o mês.
+// O gasto variável é extrapolado pelos dias decorridos; as despesas recorrentes entram pelo valor,
+// somando as já lançadas e as que ainda não geraram movimentação (PendingRecurring).
+type BudgetForecast struct {
+	ID                 string      `json:"id"`
+	ParentID           *string     `json:"parent_id"`
+	Description        string      `json:"description"`
+	Period             string      `json:"period"`
+	Amount             money.Money `json:"amount"`
+	Usage              money.Money `json:"usage"`
+	RecurringUsage     money.Money `json:"recurring_usage"`
+	PendingRecurring   money.Money `json:"pending_recurring"`
+	ElapsedDays        int         `json:"elapsed_days"`
+	PeriodDays         int         `json:"period_days"`
+	ProjectedUsage     money.Money `json:"projected_usage"`
+	ProjectedRemaining money.Money `json:"projected_remaining"`
+	ProjectedPercent   float64     `json:"projected_percent"`
+	ProjectedOverspend bool        `json:"projected_overspend"`
+}
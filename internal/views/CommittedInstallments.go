@@ -0,0 +1,20 @@
+package views
+
+import (
+	"financial-backend/pkg/money"
+	"time"
+)
+
+type CommittedInstallments struct {
+	ExpenseID   string      `json:"expense_id"`
+	Description string      `json:"description"`
+	Remaining   int64       `json:"remaining"`
+	Committed   money.Money `json:"committed"`
+	NextDueDate time.Time   `json:"next_due_date"`
+}
+
+type InstallmentsSummary struct {
+	RemainingInstallments int64                   `json:"remaining_installments"`
+	CommittedAmount       money.Money             `json:"committed_amount"`
+	Expenses              []CommittedInstallments `json:"expenses"`
+}
@@ -1,7 +1,40 @@
 package views
 
+import "financial-backend/pkg/money"
+
+// SummaryBudgetUtilization é o valor e o gasto de um orçamento no período, já consolidados com os
+// descendentes nos orçamentos pai
 type SummaryBudgetUtilization struct {
+	ID          string  `json:"id"`
+	ParentID    *string `json:"parent_id"`
 	Description string  `json:"description"`
-	Amount      float64 `json:"amount"`
-	Usage       float64 `json:"usage"`
+	Period      string  `json:"period"`
+	// Amount é o limite do orçamento. Num orçamento pai vale o seu próprio valor quando definido e, se ele
+	// for zero, a soma dos limites dos filhos; AmountSource indica qual das regras foi usada.
+	// Usage de um orçamento pai sempre soma o uso dele com o de todos os descendentes.
+	Amount       money.Money `json:"amount"`
+	AmountSource string      `json:"amount_source" gorm:"-"`
+	Usage        money.Money `json:"usage"`
+	Remaining    money.Money `json:"remaining" gorm:"-"`
+	UsedPercent  float64     `json:"used_percent" gorm:"-"`
+	// ElapsedDays e PeriodDays indicam quanto do período já passou; ExpectedPercent é o uso esperado
+	// para esse ponto se o gasto fosse linear e OverPace indica que o gasto está acima desse ritmo
+	ElapsedDays     int     `json:"elapsed_days" gorm:"-"`
+	PeriodDays      int     `json:"period_days" gorm:"-"`
+	ExpectedPercent float64 `json:"expected_percent" gorm:"-"`
+	OverPace        bool    `json:"over_pace" gorm:"-"`
+	HasChildren     bool    `json:"has_children"`
+}
+
+const (
+	// AmountFromOwn indica que o limite é o valor do próprio orçamento
+	AmountFromOwn = "own"
+	// AmountFromChildren indica que o limite é a soma dos limites dos filhos, pois o orçamento pai não tem valor próprio
+	AmountFromChildren = "children"
+)
+
+// BudgetUtilizationDrillDown detalha a utilização de um orçamento pai pelos seus filhos diretos
+type BudgetUtilizationDrillDown struct {
+	SummaryBudgetUtilization
+	Children []SummaryBudgetUtilization `json:"children"`
 }
@@ -1,7 +1,9 @@
 package views
 
+import "financial-backend/pkg/money"
+
 type SummaryView struct {
-	TotalIncome    float64 `json:"total_income"`
-	TotalExpense   float64 `json:"total_expense"`
-	TotalRemaining float64 `json:"total_remaining"`
+	TotalIncome    money.Money `json:"total_income"`
+	TotalExpense   money.Money `json:"total_expense"`
+	TotalRemaining money.Money `json:"total_remaining"`
 }
@@ -0,0 +1,10 @@
+package views
+
+import "financial-backend/pkg/money"
+
+type TagBreakdown struct {
+	Tag          string      `json:"tag"`
+	TotalIncome  money.Money `json:"total_income"`
+	TotalExpense money.Money `json:"total_expense"`
+	Balance      money.Money `json:"balance"`
+}
@@ -6,6 +6,7 @@ import (
 	"log"
 	"os"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -17,13 +18,23 @@ import (
 
 // Config representa as configurações da aplicação
 type Config struct {
-	ServerAddress  string
-	DBHost         string
-	DBPort         string
-	DBUser         string
-	DBPassword     string
-	DBName         string
-	DefaultDueDate int
+	ServerAddress        string
+	DBHost               string
+	DBPort               string
+	DBUser               string
+	DBPassword           string
+	DBName               string
+	DefaultDueDate       int
+	OverdueCheckInterval time.Duration
+	StorageDir           string
+	// AlertThresholds são os percentuais de alerta dos orçamentos que não definem os seus
+	AlertThresholds []int
+	WebhookTimeout  time.Duration
+	SMTPHost        string
+	SMTPPort        string
+	SMTPUser        string
+	SMTPPassword    string
+	SMTPFrom        string
 }
 
 var (
@@ -51,14 +62,40 @@ func LoadConfig() (*Config, error) {
 
 	defaultDueDate, _ := strconv.Atoi(getEnv("DEFAULT_DUE_DATE", "15"))
 
+	overdueCheckInterval, err := time.ParseDuration(getEnv("OVERDUE_CHECK_INTERVAL", "24h"))
+	if err != nil || overdueCheckInterval <= 0 {
+		overdueCheckInterval = 24 * time.Hour
+	}
+
+	webhookTimeout, err := time.ParseDuration(getEnv("WEBHOOK_TIMEOUT", "10s"))
+	if err != nil || webhookTimeout <= 0 {
+		webhookTimeout = 10 * time.Second
+	}
+
+	var alertThresholds []int
+	for _, value := range strings.Split(getEnv("BUDGET_ALERT_THRESHOLDS", "50,80,100"), ",") {
+		if threshold, err := strconv.Atoi(strings.TrimSpace(value)); err == nil && threshold > 0 {
+			alertThresholds = append(alertThresholds, threshold)
+		}
+	}
+
 	config := &Config{
-		ServerAddress:  getEnv("SERVER_ADDRESS", ":8080"),
-		DBHost:         getEnv("DB_HOST", "localhost"),
-		DBPort:         getEnv("DB_PORT", "5432"),
-		DBUser:         getEnv("DB_USER", "postgres"),
-		DBPassword:     getEnv("DB_PASSWORD", "postgres"),
-		DBName:         getEnv("DB_NAME", "financial"),
-		DefaultDueDate: defaultDueDate,
+		ServerAddress:        getEnv("SERVER_ADDRESS", ":8080"),
+		DBHost:               getEnv("DB_HOST", "localhost"),
+		DBPort:               getEnv("DB_PORT", "5432"),
+		DBUser:               getEnv("DB_USER", "postgres"),
+		DBPassword:           getEnv("DB_PASSWORD", "postgres"),
+		DBName:               getEnv("DB_NAME", "financial"),
+		DefaultDueDate:       defaultDueDate,
+		OverdueCheckInterval: overdueCheckInterval,
+		StorageDir:           getEnv("STORAGE_DIR", "./data/attachments"),
+		AlertThresholds:      alertThresholds,
+		WebhookTimeout:       webhookTimeout,
+		SMTPHost:             getEnv("SMTP_HOST", ""),
+		SMTPPort:             getEnv("SMTP_PORT", "587"),
+		SMTPUser:             getEnv("SMTP_USER", ""),
+		SMTPPassword:         getEnv("SMTP_PASSWORD", ""),
+		SMTPFrom:             getEnv("SMTP_FROM", ""),
 	}
 
 	return config, nil
@@ -92,7 +129,7 @@ func SetupDatabase(cfg *Config) (*gorm.DB, error) {
 	if err != nil {
 		return nil, fmt.Errorf("erro ao conectar ao banco de dados: %v", err)
 	}
-	db.AutoMigrate(&entities.Budget{}, &entities.Expense{}, &entities.Income{}, &entities.BudgetMovement{})
+	db.AutoMigrate(&entities.Budget{}, &entities.Expense{}, &entities.ExpenseAllocation{}, &entities.Income{}, &entities.BudgetMovement{}, &entities.CreditCard{}, &entities.CreditCardPayment{}, &entities.ExpenseInstallment{}, &entities.ExpensePayment{}, &entities.ExpenseOverdueNotice{}, &entities.Category{}, &entities.Tag{}, &entities.Attachment{}, &entities.Member{}, &entities.ExpenseShare{}, &entities.Settlement{}, &entities.BudgetNotifier{}, &entities.BudgetAlert{}, &entities.BudgetAmountVersion{})
 	return db, nil
 }
 
@@ -0,0 +1,191 @@
+package money
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Money representa um valor monetário exato, guardado em centavos.
+// No banco é persistido como numeric(15,2) e no JSON como número decimal (ex: 10.50).
+type Money int64
+
+// Zero é o valor monetário nulo
+const Zero Money = 0
+
+// FromCents cria um valor a partir de centavos
+func FromCents(cents int64) Money {
+	return Money(cents)
+}
+
+// FromFloat cria um valor a partir de um float, arredondando para o centavo mais próximo
+func FromFloat(value float64) Money {
+	return Money(math.Round(value * 100))
+}
+
+// Parse converte uma string decimal ("123", "123.4", "-0.50") em Money sem passar por float.
+// Casas decimais além do centavo só são aceitas quando são zeros.
+func Parse(value string) (Money, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return Zero, fmt.Errorf("valor monetário inválido: vazio")
+	}
+
+	negative := false
+	switch value[0] {
+	case '-':
+		negative = true
+		value = value[1:]
+	case '+':
+		value = value[1:]
+	}
+
+	integerPart, fractionPart, _ := strings.Cut(value, ".")
+	if integerPart == "" {
+		integerPart = "0"
+	}
+
+	if len(fractionPart) > 2 {
+		if strings.Trim(fractionPart[2:], "0") != "" {
+			return Zero, fmt.Errorf("valor monetário inválido: %q possui mais de duas casas decimais", value)
+		}
+		fractionPart = fractionPart[:2]
+	}
+	for len(fractionPart) < 2 {
+		fractionPart += "0"
+	}
+
+	units, err := strconv.ParseInt(integerPart, 10, 64)
+	if err != nil || units < 0 {
+		return Zero, fmt.Errorf("valor monetário inválido: %q", value)
+	}
+	cents, err := strconv.ParseInt(fractionPart, 10, 64)
+	if err != nil || cents < 0 {
+		return Zero, fmt.Errorf("valor monetário inválido: %q", value)
+	}
+
+	result := Money(units*100 + cents)
+	if negative {
+		result = -result
+	}
+	return result, nil
+}
+
+// Cents retorna o valor em centavos
+func (m Money) Cents() int64 {
+	return int64(m)
+}
+
+// Float64 retorna o valor em reais como float. Use apenas para exibição e proporções.
+func (m Money) Float64() float64 {
+	return float64(m) / 100
+}
+
+func (m Money) Add(other Money) Money {
+	return m + other
+}
+
+func (m Money) Sub(other Money) Money {
+	return m - other
+}
+
+// Percent retorna o percentual informado do valor, arredondado para o centavo mais próximo
+func (m Money) Percent(percentage float64) Money {
+	return Money(math.Round(float64(m) * percentage / 100))
+}
+
+func (m Money) Neg() Money {
+	return -m
+}
+
+func (m Money) Abs() Money {
+	if m < 0 {
+		return -m
+	}
+	return m
+}
+
+func (m Money) IsZero() bool {
+	return m == 0
+}
+
+func (m Money) IsNegative() bool {
+	return m < 0
+}
+
+func (m Money) IsPositive() bool {
+	return m > 0
+}
+
+// String formata o valor com duas casas decimais, ex: "-12.05"
+func (m Money) String() string {
+	sign := ""
+	cents := int64(m)
+	if cents < 0 {
+		sign = "-"
+		cents = -cents
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, cents/100, cents%100)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (m Money) MarshalJSON() ([]byte, error) {
+	return []byte(m.String()), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler. Aceita número ou string decimal.
+func (m *Money) UnmarshalJSON(data []byte) error {
+	raw := strings.TrimSpace(string(data))
+	if raw == "null" {
+		return nil
+	}
+	if strings.HasPrefix(raw, `"`) {
+		var value string
+		if err := json.Unmarshal(data, &value); err != nil {
+			return err
+		}
+		raw = value
+	}
+
+	parsed, err := Parse(raw)
+	if err != nil {
+		return err
+	}
+	*m = parsed
+	return nil
+}
+
+// Value implements driver.Valuer.
+func (m Money) Value() (driver.Value, error) {
+	return m.String(), nil
+}
+
+// Scan implements sql.Scanner. Inteiros vindos do banco são tratados como reais inteiros.
+func (m *Money) Scan(src any) error {
+	switch value := src.(type) {
+	case nil:
+		*m = Zero
+	case string:
+		parsed, err := Parse(value)
+		if err != nil {
+			return err
+		}
+		*m = parsed
+	case []byte:
+		parsed, err := Parse(string(value))
+		if err != nil {
+			return err
+		}
+		*m = parsed
+	case int64:
+		*m = Money(value * 100)
+	case float64:
+		*m = FromFloat(value)
+	default:
+		return fmt.Errorf("não é possível converter %T em valor monetário", src)
+	}
+	return nil
+}
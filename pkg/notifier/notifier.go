@@ -0,0 +1,16 @@
+package notifier
+
+import "context"
+
+// Message é o conteúdo de uma notificação. Canais de texto usam Subject e Body;
+// canais estruturados, como o webhook, enviam Payload.
+type Message struct {
+	Subject string
+	Body    string
+	Payload any
+}
+
+// Notifier entrega uma mensagem ao destino informado (uma URL, um e-mail...)
+type Notifier interface {
+	Notify(ctx context.Context, target string, message Message) error
+}
@@ -0,0 +1,69 @@
+package notifier
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mime"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strings"
+)
+
+// SMTPConfig reúne os dados do servidor usado para enviar os e-mails
+type SMTPConfig struct {
+	Host     string
+	Port     string
+	User     string
+	Password string
+	From     string
+}
+
+// SMTP envia o Subject e o Body da mensagem por e-mail para o endereço de destino
+type SMTP struct {
+	config SMTPConfig
+}
+
+func NewSMTP(config SMTPConfig) *SMTP {
+	return &SMTP{config: config}
+}
+
+func (s *SMTP) Notify(_ context.Context, target string, message Message) error {
+	if s.config.Host == "" {
+		return errors.New("servidor SMTP não configurado")
+	}
+
+	recipient, err := mail.ParseAddress(target)
+	if err != nil {
+		return fmt.Errorf("e-mail inválido: %s", target)
+	}
+
+	var auth smtp.Auth
+	if s.config.User != "" {
+		auth = smtp.PlainAuth("", s.config.User, s.config.Password, s.config.Host)
+	}
+
+	content := strings.Join([]string{
+		"From: " + s.config.From,
+		"To: " + recipient.Address,
+		"Subject: " + encodeHeader(message.Subject),
+		"MIME-Version: 1.0",
+		"Content-Type: text/plain; charset=UTF-8",
+		"",
+		message.Body,
+	}, "\r\n")
+
+	address := net.JoinHostPort(s.config.Host, s.config.Port)
+	if err := smtp.SendMail(address, auth, s.config.From, []string{recipient.Address}, []byte(content)); err != nil {
+		return fmt.Errorf("erro ao enviar e-mail: %w", err)
+	}
+	return nil
+}
+
+// encodeHeader remove as quebras de linha do valor, para que ele não crie outros cabeçalhos,
+// e o codifica quando tem caracteres fora do ASCII, como os acentos das descrições dos orçamentos
+func encodeHeader(value string) string {
+	value = strings.NewReplacer("\r", " ", "\n", " ").Replace(value)
+	return mime.QEncoding.Encode("UTF-8", value)
+}
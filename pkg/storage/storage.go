@@ -0,0 +1,17 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+// ErrNotFound é retornado quando a chave não existe no armazenamento
+var ErrNotFound = errors.New("arquivo não encontrado")
+
+// BlobStore armazena o conteúdo binário de arquivos identificados por uma chave
+type BlobStore interface {
+	Put(ctx context.Context, key string, content io.Reader) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}